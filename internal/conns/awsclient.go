@@ -60,8 +60,13 @@ func (client *AWSClient) RegionalHostname(prefix string) string {
 func (client *AWSClient) S3ConnURICleaningDisabled(ctx context.Context) *s3_sdkv1.S3 {
 	config := client.S3Conn(ctx).Config
 	config.DisableRestProtocolURICleaning = aws_sdkv1.Bool(true)
+	conn := s3_sdkv1.New(client.Session.Copy(&config))
 
-	return s3_sdkv1.New(client.Session.Copy(&config))
+	if client.endpoints[names.S3] == "" {
+		RegisterS3ExpressHandlers(conn, client.DNSSuffix)
+	}
+
+	return conn
 }
 
 // SetHTTPClient sets the http.Client used for AWS API calls.
@@ -145,6 +150,7 @@ func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]a
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = client.s3UsePathStyle
+		m["dns_suffix"] = client.DNSSuffix
 	case names.STS:
 		m["sts_region"] = client.stsRegion
 	}
@@ -217,7 +217,6 @@ import (
 	lookoutforvision_sdkv1 "github.com/aws/aws-sdk-go/service/lookoutforvision"
 	lookoutmetrics_sdkv1 "github.com/aws/aws-sdk-go/service/lookoutmetrics"
 	machinelearning_sdkv1 "github.com/aws/aws-sdk-go/service/machinelearning"
-	macie_sdkv1 "github.com/aws/aws-sdk-go/service/macie"
 	macie2_sdkv1 "github.com/aws/aws-sdk-go/service/macie2"
 	managedblockchain_sdkv1 "github.com/aws/aws-sdk-go/service/managedblockchain"
 	managedgrafana_sdkv1 "github.com/aws/aws-sdk-go/service/managedgrafana"
@@ -1093,10 +1092,6 @@ func (c *AWSClient) MachineLearningConn(ctx context.Context) *machinelearning_sd
 	return errs.Must(conn[*machinelearning_sdkv1.MachineLearning](ctx, c, names.MachineLearning))
 }
 
-func (c *AWSClient) MacieConn(ctx context.Context) *macie_sdkv1.Macie {
-	return errs.Must(conn[*macie_sdkv1.Macie](ctx, c, names.Macie))
-}
-
 func (c *AWSClient) Macie2Conn(ctx context.Context) *macie2_sdkv1.Macie2 {
 	return errs.Must(conn[*macie2_sdkv1.Macie2](ctx, c, names.Macie2))
 }
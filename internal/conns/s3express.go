@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	credentials_sdkv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	v4_sdkv1 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	s3_sdkv1 "github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	s3ExpressSigningName = "s3express"
+	// Session credentials are valid for 5 minutes. Refresh them before they expire.
+	s3ExpressSessionRefreshWindow = 1 * time.Minute
+)
+
+// Directory bucket names have the format "bucket-base-name--azid--x-s3".
+var s3DirectoryBucketNameRegexp = regexp.MustCompile(`^[0-9a-z][0-9a-z-]*--([0-9a-z]+-az[0-9]+)--x-s3$`)
+
+// S3DirectoryBucketAvailabilityZoneID returns the ID of the Availability Zone of an S3 Express One Zone directory bucket.
+// The second return value is false if the bucket name isn't a directory bucket name.
+func S3DirectoryBucketAvailabilityZoneID(bucket string) (string, bool) {
+	m := s3DirectoryBucketNameRegexp.FindStringSubmatch(bucket)
+
+	if m == nil {
+		return "", false
+	}
+
+	return m[1], true
+}
+
+// Operations on directory buckets that are sent to the Regional endpoint.
+// All other operations are sent to the bucket's Zonal endpoint and are authenticated with session credentials.
+var s3ExpressRegionalOperations = map[string]bool{
+	"CreateBucket":         true,
+	"DeleteBucket":         true,
+	"DeleteBucketPolicy":   true,
+	"GetBucketPolicy":      true,
+	"ListDirectoryBuckets": true,
+	"PutBucketPolicy":      true,
+}
+
+// s3ExpressSessions caches the CreateSession credentials of each directory bucket.
+type s3ExpressSessions struct {
+	conn        *s3_sdkv1.S3
+	credentials map[string]*s3_sdkv1.SessionCredentials
+	dnsSuffix   string
+	lock        sync.Mutex
+}
+
+// RegisterS3ExpressHandlers adds request handlers to an S3 API client that route requests for
+// S3 Express One Zone directory buckets to the Regional and Zonal endpoints and authenticate
+// Zonal requests with the session credentials returned by CreateSession.
+// The AWS SDK for Go v1 has no built-in support for directory buckets.
+// Requests for general purpose buckets are unchanged.
+func RegisterS3ExpressHandlers(conn *s3_sdkv1.S3, dnsSuffix string) {
+	sessions := &s3ExpressSessions{
+		conn:        conn,
+		credentials: make(map[string]*s3_sdkv1.SessionCredentials),
+		dnsSuffix:   dnsSuffix,
+	}
+
+	conn.Handlers.Build.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "tf.S3ExpressHandler",
+		Fn:   sessions.build,
+	})
+}
+
+func (s *s3ExpressSessions) build(r *request_sdkv1.Request) {
+	if r.Error != nil {
+		return
+	}
+
+	region := aws_sdkv1.StringValue(r.Config.Region)
+	operation := r.Operation.Name
+	bucket := s3RequestBucket(r.Params)
+
+	if operation == "ListDirectoryBuckets" {
+		r.HTTPRequest.URL.Host = fmt.Sprintf("s3express-control.%s.%s", region, s.dnsSuffix)
+		s3ExpressSign(r)
+
+		return
+	}
+
+	azID, ok := S3DirectoryBucketAvailabilityZoneID(bucket)
+	if !ok {
+		return
+	}
+
+	if s3ExpressRegionalOperations[operation] {
+		s3ExpressSetEndpoint(r, fmt.Sprintf("s3express-control.%s.%s", region, s.dnsSuffix), bucket, true)
+		s3ExpressSign(r)
+
+		return
+	}
+
+	s3ExpressSetEndpoint(r, fmt.Sprintf("%s.s3express-%s.%s.%s", bucket, azID, region, s.dnsSuffix), bucket, false)
+	s3ExpressSign(r)
+
+	if operation == "CreateSession" {
+		return
+	}
+
+	credentials, err := s.sessionCredentials(r, bucket)
+
+	if err != nil {
+		r.Error = fmt.Errorf("creating S3 Express session for directory bucket (%s): %w", bucket, err)
+		return
+	}
+
+	r.Config.Credentials = credentials_sdkv1.NewStaticCredentials(aws_sdkv1.StringValue(credentials.AccessKeyId), aws_sdkv1.StringValue(credentials.SecretAccessKey), "")
+	r.HTTPRequest.Header.Set("X-Amz-S3session-Token", aws_sdkv1.StringValue(credentials.SessionToken))
+}
+
+// sessionCredentials returns cached or new session credentials for the directory bucket.
+func (s *s3ExpressSessions) sessionCredentials(r *request_sdkv1.Request, bucket string) (*s3_sdkv1.SessionCredentials, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if v, ok := s.credentials[bucket]; ok && time.Until(aws_sdkv1.TimeValue(v.Expiration)) > s3ExpressSessionRefreshWindow {
+		return v, nil
+	}
+
+	output, err := s.conn.CreateSessionWithContext(r.Context(), &s3_sdkv1.CreateSessionInput{
+		Bucket: aws_sdkv1.String(bucket),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Credentials == nil {
+		return nil, fmt.Errorf("empty result")
+	}
+
+	s.credentials[bucket] = output.Credentials
+
+	return output.Credentials, nil
+}
+
+// s3ExpressSetEndpoint replaces the host of the request's URL and moves the bucket name
+// between the host and the path as required by the endpoint's addressing style.
+func s3ExpressSetEndpoint(r *request_sdkv1.Request, host, bucket string, pathStyle bool) {
+	u := r.HTTPRequest.URL
+
+	if prefix := bucket + "."; !strings.HasPrefix(u.Host, prefix) {
+		// Path-style request.
+		u.Path = s3ExpressTrimBucket(u.Path, bucket)
+		u.RawPath = s3ExpressTrimBucket(u.RawPath, bucket)
+	}
+
+	u.Host = host
+
+	if pathStyle {
+		u.Path = s3ExpressPrependBucket(u.Path, bucket)
+		if u.RawPath != "" {
+			u.RawPath = s3ExpressPrependBucket(u.RawPath, bucket)
+		}
+	}
+}
+
+func s3ExpressTrimBucket(path, bucket string) string {
+	if path == "" {
+		return path
+	}
+
+	prefix := "/" + bucket
+
+	if path == prefix {
+		return "/"
+	}
+
+	if strings.HasPrefix(path, prefix+"/") {
+		return strings.TrimPrefix(path, prefix)
+	}
+
+	return path
+}
+
+func s3ExpressPrependBucket(path, bucket string) string {
+	if path == "" || path == "/" {
+		return "/" + bucket
+	}
+
+	return "/" + bucket + path
+}
+
+// s3ExpressSign signs the request with the S3 Express signing name.
+// The payload isn't hashed, matching the AWS SDKs with built-in support for directory buckets.
+func s3ExpressSign(r *request_sdkv1.Request) {
+	r.ClientInfo.SigningName = s3ExpressSigningName
+	r.Handlers.Sign.Swap(v4_sdkv1.SignRequestHandler.Name, v4_sdkv1.BuildNamedHandler(v4_sdkv1.SignRequestHandler.Name, func(s *v4_sdkv1.Signer) {
+		s.DisableURIPathEscaping = true
+		s.UnsignedPayload = true
+	}))
+}
+
+// s3RequestBucket returns the value of the request parameters' Bucket field, if any.
+func s3RequestBucket(params any) string {
+	v := reflect.Indirect(reflect.ValueOf(params))
+
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	if f := v.FieldByName("Bucket"); f.IsValid() {
+		if s, ok := f.Interface().(*string); ok {
+			return aws_sdkv1.StringValue(s)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	credentials_sdkv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	s3_sdkv1 "github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestS3DirectoryBucketAvailabilityZoneID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Bucket   string
+		Expected string
+		OK       bool
+	}{
+		{Bucket: "example--usw2-az1--x-s3", Expected: "usw2-az1", OK: true},
+		{Bucket: "my-bucket--use1-az4--x-s3", Expected: "use1-az4", OK: true},
+		{Bucket: "example"},
+		{Bucket: "example--x-s3"},
+		{Bucket: "example--usw2-az1"},
+		{Bucket: "Example--usw2-az1--x-s3"},
+	}
+
+	for _, testCase := range testCases {
+		got, ok := S3DirectoryBucketAvailabilityZoneID(testCase.Bucket)
+
+		if ok != testCase.OK || got != testCase.Expected {
+			t.Errorf("S3DirectoryBucketAvailabilityZoneID(%q) = %q, %t, expected %q, %t", testCase.Bucket, got, ok, testCase.Expected, testCase.OK)
+		}
+	}
+}
+
+func TestRegisterS3ExpressHandlers(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name            string
+		PathStyle       bool
+		Request         func(*s3_sdkv1.S3) *request_sdkv1.Request
+		ExpectedHost    string
+		ExpectedPath    string
+		ExpectedSigning string
+	}{
+		{
+			Name: "general purpose bucket",
+			Request: func(conn *s3_sdkv1.S3) *request_sdkv1.Request {
+				r, _ := conn.GetBucketPolicyRequest(&s3_sdkv1.GetBucketPolicyInput{Bucket: aws_sdkv1.String("example")})
+				return r
+			},
+			ExpectedHost:    "example.s3.us-west-2.amazonaws.com",
+			ExpectedPath:    "/",
+			ExpectedSigning: "s3",
+		},
+		{
+			Name: "list directory buckets",
+			Request: func(conn *s3_sdkv1.S3) *request_sdkv1.Request {
+				r, _ := conn.ListDirectoryBucketsRequest(&s3_sdkv1.ListDirectoryBucketsInput{})
+				return r
+			},
+			ExpectedHost:    "s3express-control.us-west-2.amazonaws.com",
+			ExpectedPath:    "/",
+			ExpectedSigning: s3ExpressSigningName,
+		},
+		{
+			Name: "regional operation",
+			Request: func(conn *s3_sdkv1.S3) *request_sdkv1.Request {
+				r, _ := conn.GetBucketPolicyRequest(&s3_sdkv1.GetBucketPolicyInput{Bucket: aws_sdkv1.String("example--usw2-az1--x-s3")})
+				return r
+			},
+			ExpectedHost:    "s3express-control.us-west-2.amazonaws.com",
+			ExpectedPath:    "/example--usw2-az1--x-s3",
+			ExpectedSigning: s3ExpressSigningName,
+		},
+		{
+			Name:      "regional operation path style",
+			PathStyle: true,
+			Request: func(conn *s3_sdkv1.S3) *request_sdkv1.Request {
+				r, _ := conn.DeleteBucketRequest(&s3_sdkv1.DeleteBucketInput{Bucket: aws_sdkv1.String("example--usw2-az1--x-s3")})
+				return r
+			},
+			ExpectedHost:    "s3express-control.us-west-2.amazonaws.com",
+			ExpectedPath:    "/example--usw2-az1--x-s3",
+			ExpectedSigning: s3ExpressSigningName,
+		},
+		{
+			Name: "zonal operation",
+			Request: func(conn *s3_sdkv1.S3) *request_sdkv1.Request {
+				r, _ := conn.CreateSessionRequest(&s3_sdkv1.CreateSessionInput{Bucket: aws_sdkv1.String("example--usw2-az1--x-s3")})
+				return r
+			},
+			ExpectedHost:    "example--usw2-az1--x-s3.s3express-usw2-az1.us-west-2.amazonaws.com",
+			ExpectedPath:    "/",
+			ExpectedSigning: s3ExpressSigningName,
+		},
+		{
+			Name:      "zonal operation path style",
+			PathStyle: true,
+			Request: func(conn *s3_sdkv1.S3) *request_sdkv1.Request {
+				r, _ := conn.CreateSessionRequest(&s3_sdkv1.CreateSessionInput{Bucket: aws_sdkv1.String("example--usw2-az1--x-s3")})
+				return r
+			},
+			ExpectedHost:    "example--usw2-az1--x-s3.s3express-usw2-az1.us-west-2.amazonaws.com",
+			ExpectedPath:    "/",
+			ExpectedSigning: s3ExpressSigningName,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			sess := session_sdkv1.Must(session_sdkv1.NewSession(&aws_sdkv1.Config{
+				Credentials:      credentials_sdkv1.NewStaticCredentials("AKID", "SECRET", ""),
+				Region:           aws_sdkv1.String("us-west-2"),
+				S3ForcePathStyle: aws_sdkv1.Bool(testCase.PathStyle),
+			}))
+			conn := s3_sdkv1.New(sess)
+			RegisterS3ExpressHandlers(conn, "amazonaws.com")
+
+			r := testCase.Request(conn)
+
+			if err := r.Build(); err != nil {
+				t.Fatalf("building request: %s", err)
+			}
+
+			if got := r.HTTPRequest.URL.Host; got != testCase.ExpectedHost {
+				t.Errorf("host: got %s, expected %s", got, testCase.ExpectedHost)
+			}
+
+			if got := r.HTTPRequest.URL.Path; got != testCase.ExpectedPath {
+				t.Errorf("path: got %s, expected %s", got, testCase.ExpectedPath)
+			}
+
+			if got := r.ClientInfo.SigningName; got != testCase.ExpectedSigning {
+				t.Errorf("signing name: got %q, expected %q", got, testCase.ExpectedSigning)
+			}
+		})
+	}
+}
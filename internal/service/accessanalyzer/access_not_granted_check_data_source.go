@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_accessanalyzer_access_not_granted_check")
+func dataSourceAccessNotGrantedCheck() *schema.Resource {
+	s := accessCheckResultSchema()
+	s["actions"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		MinItems: 1,
+		MaxItems: 100,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	s["policy_document"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsJSON,
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAccessNotGrantedCheckRead,
+
+		Schema: s,
+	}
+}
+
+const (
+	DSNameAccessNotGrantedCheck = "Access Not Granted Check Data Source"
+)
+
+func dataSourceAccessNotGrantedCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	policyType := d.Get("policy_type").(string)
+	input := &accessanalyzer.CheckAccessNotGrantedInput{
+		Access: []types.Access{{
+			Actions: flex.ExpandStringValueSet(d.Get("actions").(*schema.Set)),
+		}},
+		PolicyDocument: aws.String(d.Get("policy_document").(string)),
+		PolicyType:     types.AccessCheckPolicyType(policyType),
+	}
+
+	output, err := conn.CheckAccessNotGranted(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.AccessAnalyzer, create.ErrActionReading, DSNameAccessNotGrantedCheck, policyType, err)
+	}
+
+	d.SetId(policyType)
+	d.Set("message", output.Message)
+	d.Set("passed", output.Result == types.CheckAccessNotGrantedResultPass)
+	if err := d.Set("reasons", flattenReasonSummaries(output.Reasons)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting reasons: %s", err)
+	}
+	d.Set("result", output.Result)
+
+	return diags
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAccessAnalyzerAccessNotGrantedCheckDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_access_not_granted_check.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessNotGrantedCheckDataSourceConfig_basic("s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "passed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "result", "PASS"),
+				),
+			},
+			{
+				Config: testAccAccessNotGrantedCheckDataSourceConfig_basic("s3:*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "passed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "result", "FAIL"),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.0.statement_index", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessNotGrantedCheckDataSourceConfig_basic(action string) string {
+	return fmt.Sprintf(`
+data "aws_accessanalyzer_access_not_granted_check" "test" {
+  policy_type = "IDENTITY_POLICY"
+  actions     = ["s3:DeleteBucket"]
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = %[1]q
+      Resource = "*"
+    }]
+  })
+}
+`, action)
+}
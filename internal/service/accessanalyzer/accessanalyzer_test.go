@@ -22,12 +22,17 @@ func TestAccAccessAnalyzer_serial(t *testing.T) {
 			"disappears":        testAccAnalyzer_disappears,
 			"tags":              testAccAnalyzer_tags,
 			"Type_Organization": testAccAnalyzer_Type_Organization,
+			"UnusedAccess":      testAccAnalyzer_unusedAccess,
 		},
 		"ArchiveRule": {
 			"basic":          testAccAnalyzerArchiveRule_basic,
 			"disappears":     testAccAnalyzerArchiveRule_disappears,
 			"update_filters": testAccAnalyzerArchiveRule_updateFilters,
 		},
+		"UnusedAccessFindingsDataSource": {
+			"basic":  testAccUnusedAccessFindingsDataSource_basic,
+			"filter": testAccUnusedAccessFindingsDataSource_filter,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_accessanalyzer_no_new_access_check")
+func dataSourceNoNewAccessCheck() *schema.Resource {
+	s := accessCheckResultSchema()
+	s["existing_policy_document"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsJSON,
+	}
+	s["new_policy_document"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsJSON,
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceNoNewAccessCheckRead,
+
+		Schema: s,
+	}
+}
+
+const (
+	DSNameNoNewAccessCheck = "No New Access Check Data Source"
+)
+
+func dataSourceNoNewAccessCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	policyType := d.Get("policy_type").(string)
+	input := &accessanalyzer.CheckNoNewAccessInput{
+		ExistingPolicyDocument: aws.String(d.Get("existing_policy_document").(string)),
+		NewPolicyDocument:      aws.String(d.Get("new_policy_document").(string)),
+		PolicyType:             types.AccessCheckPolicyType(policyType),
+	}
+
+	output, err := conn.CheckNoNewAccess(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.AccessAnalyzer, create.ErrActionReading, DSNameNoNewAccessCheck, policyType, err)
+	}
+
+	d.SetId(policyType)
+	d.Set("message", output.Message)
+	d.Set("passed", output.Result == types.CheckNoNewAccessResultPass)
+	if err := d.Set("reasons", flattenReasonSummaries(output.Reasons)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting reasons: %s", err)
+	}
+	d.Set("result", output.Result)
+
+	return diags
+}
+
+// accessCheckResultSchema returns the attributes shared by the CheckNoNewAccess and CheckAccessNotGranted data sources.
+func accessCheckResultSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"message": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"passed": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"policy_type": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: enum.Validate[types.AccessCheckPolicyType](),
+		},
+		"reasons": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"description": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"statement_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"statement_index": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"result": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func flattenReasonSummaries(apiObjects []types.ReasonSummary) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"description":     aws.ToString(apiObject.Description),
+			"statement_id":    aws.ToString(apiObject.StatementId),
+			"statement_index": aws.ToInt32(apiObject.StatementIndex),
+		})
+	}
+
+	return tfList
+}
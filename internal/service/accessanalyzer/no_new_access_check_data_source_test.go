@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAccessAnalyzerNoNewAccessCheckDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_no_new_access_check.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNoNewAccessCheckDataSourceConfig_basic("s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "passed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "result", "PASS"),
+				),
+			},
+			{
+				Config: testAccNoNewAccessCheckDataSourceConfig_basic("s3:*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "passed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "result", "FAIL"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "message"),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "reasons.#", 0),
+				),
+			},
+		},
+	})
+}
+
+func testAccNoNewAccessCheckDataSourceConfig_basic(action string) string {
+	return `
+data "aws_accessanalyzer_no_new_access_check" "test" {
+  policy_type = "IDENTITY_POLICY"
+  existing_policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:GetObject", "s3:ListBucket"]
+      Resource = "*"
+    }]
+  })
+  new_policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "` + action + `"
+      Resource = "*"
+    }]
+  })
+}
+`
+}
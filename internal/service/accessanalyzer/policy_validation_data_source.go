@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_accessanalyzer_policy_validation")
+func dataSourcePolicyValidation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyValidationRead,
+
+		Schema: map[string]*schema.Schema{
+			"fail_on_finding_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[types.ValidatePolicyFindingType](),
+				},
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"finding_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issue_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"learn_more_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"locations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"span": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"end":   positionSchema(),
+												"start": positionSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"locale": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.Locale](),
+			},
+			"policy_document": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"policy_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.PolicyType](),
+			},
+			"validate_policy_resource_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ValidatePolicyResourceType](),
+			},
+		},
+	}
+}
+
+func positionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"column": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"line": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"offset": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+const (
+	DSNamePolicyValidation = "Policy Validation Data Source"
+)
+
+func dataSourcePolicyValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	policyType := d.Get("policy_type").(string)
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(d.Get("policy_document").(string)),
+		PolicyType:     types.PolicyType(policyType),
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = types.Locale(v.(string))
+	}
+
+	if v, ok := d.GetOk("validate_policy_resource_type"); ok {
+		input.ValidatePolicyResourceType = types.ValidatePolicyResourceType(v.(string))
+	}
+
+	findings, err := findValidatePolicyFindings(ctx, conn, input)
+
+	if err != nil {
+		return create.DiagError(names.AccessAnalyzer, create.ErrActionReading, DSNamePolicyValidation, policyType, err)
+	}
+
+	d.SetId(policyType)
+	if err := d.Set("findings", flattenValidatePolicyFindings(findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+
+	failOn := d.Get("fail_on_finding_types").(*schema.Set)
+	for _, finding := range findings {
+		if !failOn.Contains(string(finding.FindingType)) {
+			continue
+		}
+
+		diags = append(diags, errs.NewAttributeErrorDiagnostic(
+			cty.GetAttrPath("policy_document"),
+			fmt.Sprintf("Access Analyzer policy validation %s: %s", finding.FindingType, aws.ToString(finding.IssueCode)),
+			policyValidationFindingDetail(finding),
+		))
+	}
+
+	return diags
+}
+
+func findValidatePolicyFindings(ctx context.Context, conn *accessanalyzer.Client, input *accessanalyzer.ValidatePolicyInput) ([]types.ValidatePolicyFinding, error) {
+	var output []types.ValidatePolicyFinding
+
+	pages := accessanalyzer.NewValidatePolicyPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
+
+func policyValidationFindingDetail(finding types.ValidatePolicyFinding) string {
+	var sb strings.Builder
+
+	sb.WriteString(aws.ToString(finding.FindingDetails))
+
+	for _, location := range finding.Locations {
+		sb.WriteString("\n\nLocation: ")
+		sb.WriteString(flattenPathElements(location.Path))
+
+		if span := location.Span; span != nil && span.Start != nil {
+			fmt.Fprintf(&sb, " (line %d, column %d)", aws.ToInt32(span.Start.Line), aws.ToInt32(span.Start.Column))
+		}
+	}
+
+	if v := aws.ToString(finding.LearnMoreLink); v != "" {
+		sb.WriteString("\n\nLearn more: ")
+		sb.WriteString(v)
+	}
+
+	return sb.String()
+}
+
+func flattenValidatePolicyFindings(apiObjects []types.ValidatePolicyFinding) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"finding_details": aws.ToString(apiObject.FindingDetails),
+			"finding_type":    string(apiObject.FindingType),
+			"issue_code":      aws.ToString(apiObject.IssueCode),
+			"learn_more_link": aws.ToString(apiObject.LearnMoreLink),
+			"locations":       flattenLocations(apiObject.Locations),
+		})
+	}
+
+	return tfList
+}
+
+func flattenLocations(apiObjects []types.Location) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"path": flattenPathElements(apiObject.Path),
+		}
+
+		if v := apiObject.Span; v != nil {
+			tfMap["span"] = []interface{}{map[string]interface{}{
+				"end":   flattenPosition(v.End),
+				"start": flattenPosition(v.Start),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPosition(apiObject *types.Position) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"column": aws.ToInt32(apiObject.Column),
+		"line":   aws.ToInt32(apiObject.Line),
+		"offset": aws.ToInt32(apiObject.Offset),
+	}}
+}
+
+// flattenPathElements renders a policy document path, e.g. "Statement[0].Action[1]".
+func flattenPathElements(apiObjects []types.PathElement) string {
+	var sb strings.Builder
+
+	for _, apiObject := range apiObjects {
+		switch v := apiObject.(type) {
+		case *types.PathElementMemberIndex:
+			fmt.Fprintf(&sb, "[%d]", v.Value)
+		case *types.PathElementMemberKey:
+			if sb.Len() > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(v.Value)
+		case *types.PathElementMemberSubstring:
+			fmt.Fprintf(&sb, "[%d:%d]", aws.ToInt32(v.Value.Start), aws.ToInt32(v.Value.Start)+aws.ToInt32(v.Value.Length))
+		case *types.PathElementMemberValue:
+			fmt.Fprintf(&sb, "[%q]", v.Value)
+		}
+	}
+
+	return sb.String()
+}
@@ -23,7 +23,24 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceAccessNotGrantedCheck,
+			TypeName: "aws_accessanalyzer_access_not_granted_check",
+		},
+		{
+			Factory:  dataSourceNoNewAccessCheck,
+			TypeName: "aws_accessanalyzer_no_new_access_check",
+		},
+		{
+			Factory:  dataSourcePolicyValidation,
+			TypeName: "aws_accessanalyzer_policy_validation",
+		},
+		{
+			Factory:  dataSourceUnusedAccessFindings,
+			TypeName: "aws_accessanalyzer_unused_access_findings",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
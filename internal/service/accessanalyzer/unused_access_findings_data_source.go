@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_accessanalyzer_unused_access_findings")
+func dataSourceUnusedAccessFindings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceUnusedAccessFindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"analyzer_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"criteria": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"contains": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"eq": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"exists": {
+							Type:         nullable.TypeNullableBool,
+							Optional:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableBool,
+						},
+						"neq": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"analyzed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_owner_account": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	DSNameUnusedAccessFindings = "Unused Access Findings Data Source"
+)
+
+func dataSourceUnusedAccessFindingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	analyzerARN := d.Get("analyzer_arn").(string)
+	input := &accessanalyzer.ListFindingsV2Input{
+		AnalyzerArn: aws.String(analyzerARN),
+		Filter:      expandFilter(d.Get("filter").(*schema.Set)),
+	}
+
+	findings, err := findFindingsV2(ctx, conn, input)
+
+	if err != nil {
+		return create.DiagError(names.AccessAnalyzer, create.ErrActionReading, DSNameUnusedAccessFindings, analyzerARN, err)
+	}
+
+	d.SetId(analyzerARN)
+	if err := d.Set("findings", flattenFindingSummaryV2s(findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+
+	return diags
+}
+
+func findFindingsV2(ctx context.Context, conn *accessanalyzer.Client, input *accessanalyzer.ListFindingsV2Input) ([]types.FindingSummaryV2, error) {
+	var output []types.FindingSummaryV2
+
+	pages := accessanalyzer.NewListFindingsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
+
+func flattenFindingSummaryV2s(apiObjects []types.FindingSummaryV2) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"analyzed_at":            aws.ToTime(apiObject.AnalyzedAt).Format(time.RFC3339),
+			"created_at":             aws.ToTime(apiObject.CreatedAt).Format(time.RFC3339),
+			"error":                  aws.ToString(apiObject.Error),
+			"finding_type":           string(apiObject.FindingType),
+			"id":                     aws.ToString(apiObject.Id),
+			"resource":               aws.ToString(apiObject.Resource),
+			"resource_owner_account": aws.ToString(apiObject.ResourceOwnerAccount),
+			"resource_type":          string(apiObject.ResourceType),
+			"status":                 string(apiObject.Status),
+			"updated_at":             aws.ToTime(apiObject.UpdatedAt).Format(time.RFC3339),
+		})
+	}
+
+	return tfList
+}
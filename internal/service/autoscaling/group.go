@@ -5,6 +5,7 @@ package autoscaling
 
 import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
@@ -167,6 +168,20 @@ func ResourceGroup() *schema.Resource {
 							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"alarm_specification": {
+										Type:     schema.TypeList,
+										MaxItems: 1,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"alarms": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
 									"auto_rollback": {
 										Type:     schema.TypeBool,
 										Optional: true,
@@ -194,11 +209,21 @@ func ResourceGroup() *schema.Resource {
 										Default:      90,
 										ValidateFunc: validation.IntBetween(0, 100),
 									},
+									"scale_in_protected_instances": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(autoscaling.ScaleInProtectedInstances_Values(), false),
+									},
 									"skip_matching": {
 										Type:     schema.TypeBool,
 										Optional: true,
 										Default:  false,
 									},
+									"standby_instances": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(autoscaling.StandbyInstances_Values(), false),
+									},
 								},
 							},
 						},
@@ -215,6 +240,11 @@ func ResourceGroup() *schema.Resource {
 								ValidateDiagFunc: validateGroupInstanceRefreshTriggerFields,
 							},
 						},
+						"wait_for_instance_refresh": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -1476,9 +1506,17 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 				mixedInstancesPolicy = expandMixedInstancesPolicy(v.([]interface{})[0].(map[string]interface{}))
 			}
 
-			if err := startInstanceRefresh(ctx, conn, expandStartInstanceRefreshInput(d.Id(), tfMap, launchTemplate, mixedInstancesPolicy)); err != nil {
+			instanceRefreshID, err := startInstanceRefresh(ctx, conn, expandStartInstanceRefreshInput(d.Id(), tfMap, launchTemplate, mixedInstancesPolicy))
+
+			if err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
+
+			if tfMap["wait_for_instance_refresh"].(bool) {
+				if _, err := waitInstanceRefreshSuccessful(ctx, conn, d.Id(), instanceRefreshID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group (%s) instance refresh (%s): %s", d.Id(), instanceRefreshID, err)
+				}
+			}
 		}
 	}
 
@@ -2578,6 +2616,72 @@ func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.AutoSca
 	return nil, err
 }
 
+func waitInstanceRefreshSuccessful(ctx context.Context, conn *autoscaling.AutoScaling, name, id string, timeout time.Duration) (*autoscaling.InstanceRefresh, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			autoscaling.InstanceRefreshStatusCancelling,
+			autoscaling.InstanceRefreshStatusInProgress,
+			autoscaling.InstanceRefreshStatusPending,
+			autoscaling.InstanceRefreshStatusRollbackInProgress,
+		},
+		Target:  []string{autoscaling.InstanceRefreshStatusSuccessful},
+		Refresh: statusInstanceRefreshProgress(ctx, conn, name, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+		tfresource.SetLastError(err, instanceRefreshError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// statusInstanceRefreshProgress is statusInstanceRefresh that also logs the refresh's progress.
+func statusInstanceRefreshProgress(ctx context.Context, conn *autoscaling.AutoScaling, name, id string) retry.StateRefreshFunc {
+	refresh := statusInstanceRefresh(ctx, conn, name, id)
+
+	return func() (interface{}, string, error) {
+		outputRaw, status, err := refresh()
+
+		if output, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+			if v := output.RollbackDetails; status == autoscaling.InstanceRefreshStatusRollbackInProgress && v != nil {
+				log.Printf("[INFO] Auto Scaling Group (%s) instance refresh (%s) %s: %d%% complete, %d instances to update", name, id, status, aws.Int64Value(v.PercentageCompleteOnRollback), aws.Int64Value(v.InstancesToUpdateOnRollback))
+			} else {
+				log.Printf("[INFO] Auto Scaling Group (%s) instance refresh (%s) %s: %d%% complete, %d instances to update", name, id, status, aws.Int64Value(output.PercentageComplete), aws.Int64Value(output.InstancesToUpdate))
+			}
+		}
+
+		return outputRaw, status, err
+	}
+}
+
+// instanceRefreshError returns an error describing why an instance refresh did not succeed.
+func instanceRefreshError(apiObject *autoscaling.InstanceRefresh) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	var reasons []string
+
+	if v := aws.StringValue(apiObject.StatusReason); v != "" {
+		reasons = append(reasons, v)
+	}
+
+	if v := apiObject.RollbackDetails; v != nil && aws.StringValue(v.RollbackReason) != "" {
+		reasons = append(reasons, fmt.Sprintf("rollback reason: %s", aws.StringValue(v.RollbackReason)))
+	}
+
+	if len(reasons) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(reasons, "; "))
+}
+
 func waitWarmPoolDeleted(ctx context.Context, conn *autoscaling.AutoScaling, name string, timeout time.Duration) (*autoscaling.WarmPoolConfiguration, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{autoscaling.WarmPoolStatusPendingDelete},
@@ -3181,7 +3285,9 @@ func expandStartInstanceRefreshInput(name string, tfMap map[string]interface{},
 		apiObject.Preferences = expandRefreshPreferences(v[0].(map[string]interface{}))
 
 		// "The AutoRollback parameter cannot be set to true when the DesiredConfiguration parameter is empty".
-		if aws.BoolValue(apiObject.Preferences.AutoRollback) {
+		// Skip matching compares instances against the desired configuration, so send it explicitly too when there is one.
+		hasDesiredConfiguration := launchTemplate != nil || mixedInstancesPolicy != nil
+		if aws.BoolValue(apiObject.Preferences.AutoRollback) || (aws.BoolValue(apiObject.Preferences.SkipMatching) && hasDesiredConfiguration) {
 			apiObject.DesiredConfiguration = &autoscaling.DesiredConfiguration{
 				LaunchTemplate:       launchTemplate,
 				MixedInstancesPolicy: mixedInstancesPolicy,
@@ -3203,6 +3309,10 @@ func expandRefreshPreferences(tfMap map[string]interface{}) *autoscaling.Refresh
 
 	apiObject := &autoscaling.RefreshPreferences{}
 
+	if v, ok := tfMap["alarm_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AlarmSpecification = expandAlarmSpecification(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap["auto_rollback"].(bool); ok {
 		apiObject.AutoRollback = aws.Bool(v)
 	}
@@ -3227,10 +3337,32 @@ func expandRefreshPreferences(tfMap map[string]interface{}) *autoscaling.Refresh
 		apiObject.MinHealthyPercentage = aws.Int64(int64(v))
 	}
 
+	if v, ok := tfMap["scale_in_protected_instances"].(string); ok && v != "" {
+		apiObject.ScaleInProtectedInstances = aws.String(v)
+	}
+
 	if v, ok := tfMap["skip_matching"].(bool); ok {
 		apiObject.SkipMatching = aws.Bool(v)
 	}
 
+	if v, ok := tfMap["standby_instances"].(string); ok && v != "" {
+		apiObject.StandbyInstances = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAlarmSpecification(tfMap map[string]interface{}) *autoscaling.AlarmSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &autoscaling.AlarmSpecification{}
+
+	if v, ok := tfMap["alarms"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Alarms = flex.ExpandStringList(v)
+	}
+
 	return apiObject
 }
 
@@ -3844,10 +3976,10 @@ func cancelInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, n
 	return nil
 }
 
-func startInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, input *autoscaling.StartInstanceRefreshInput) error {
+func startInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, input *autoscaling.StartInstanceRefreshInput) (string, error) {
 	name := aws.StringValue(input.AutoScalingGroupName)
 
-	_, err := tfresource.RetryWhen(ctx, instanceRefreshStartedTimeout,
+	outputRaw, err := tfresource.RetryWhen(ctx, instanceRefreshStartedTimeout,
 		func() (interface{}, error) {
 			return conn.StartInstanceRefreshWithContext(ctx, input)
 		},
@@ -3864,10 +3996,10 @@ func startInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, in
 		})
 
 	if err != nil {
-		return fmt.Errorf("starting Auto Scaling Group (%s) instance refresh: %w", name, err)
+		return "", fmt.Errorf("starting Auto Scaling Group (%s) instance refresh: %w", name, err)
 	}
 
-	return nil
+	return aws.StringValue(outputRaw.(*autoscaling.StartInstanceRefreshOutput).InstanceRefreshId), nil
 }
 
 func validateGroupInstanceRefreshTriggerFields(i interface{}, path cty.Path) diag.Diagnostics {
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+)
+
+func TestInstanceRefreshError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		instanceRefresh *autoscaling.InstanceRefresh
+		wantError       string
+	}{
+		"no reason": {
+			instanceRefresh: &autoscaling.InstanceRefresh{
+				Status: aws.String(autoscaling.InstanceRefreshStatusSuccessful),
+			},
+		},
+		"failed": {
+			instanceRefresh: &autoscaling.InstanceRefresh{
+				Status:       aws.String(autoscaling.InstanceRefreshStatusFailed),
+				StatusReason: aws.String("Instances failed to launch"),
+			},
+			wantError: "Instances failed to launch",
+		},
+		"rolled back": {
+			instanceRefresh: &autoscaling.InstanceRefresh{
+				RollbackDetails: &autoscaling.RollbackDetails{
+					RollbackReason: aws.String("Alarm cpu-high is in ALARM state"),
+				},
+				Status:       aws.String(autoscaling.InstanceRefreshStatusRollbackSuccessful),
+				StatusReason: aws.String("Instance refresh was rolled back"),
+			},
+			wantError: "Instance refresh was rolled back; rollback reason: Alarm cpu-high is in ALARM state",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := instanceRefreshError(testCase.instanceRefresh)
+
+			if testCase.wantError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.wantError)
+			}
+
+			if got := err.Error(); got != testCase.wantError {
+				t.Errorf("error = %q, want %q", got, testCase.wantError)
+			}
+		})
+	}
+}
+
+func TestExpandStartInstanceRefreshInput(t *testing.T) {
+	t.Parallel()
+
+	launchTemplate := &autoscaling.LaunchTemplateSpecification{
+		LaunchTemplateId: aws.String("lt-12345678"),
+		Version:          aws.String("2"),
+	}
+
+	tfMap := map[string]interface{}{
+		"preferences": []interface{}{
+			map[string]interface{}{
+				"alarm_specification": []interface{}{
+					map[string]interface{}{
+						"alarms": []interface{}{"cpu-high"},
+					},
+				},
+				"auto_rollback":                false,
+				"checkpoint_delay":             "",
+				"checkpoint_percentages":       []interface{}{},
+				"instance_warmup":              "",
+				"min_healthy_percentage":       90,
+				"scale_in_protected_instances": "Refresh",
+				"skip_matching":                true,
+				"standby_instances":            "Wait",
+			},
+		},
+		"strategy": "Rolling",
+	}
+
+	got := expandStartInstanceRefreshInput("test", tfMap, launchTemplate, nil)
+
+	if got.DesiredConfiguration == nil || got.DesiredConfiguration.LaunchTemplate != launchTemplate {
+		t.Errorf("expected desired configuration with launch template when skip_matching is set, got %s", got.DesiredConfiguration)
+	}
+
+	prefs := got.Preferences
+
+	if v := aws.StringValueSlice(prefs.AlarmSpecification.Alarms); len(v) != 1 || v[0] != "cpu-high" {
+		t.Errorf("alarms = %v, want [cpu-high]", v)
+	}
+
+	if v := aws.StringValue(prefs.ScaleInProtectedInstances); v != autoscaling.ScaleInProtectedInstancesRefresh {
+		t.Errorf("scale_in_protected_instances = %q, want %q", v, autoscaling.ScaleInProtectedInstancesRefresh)
+	}
+
+	if v := aws.StringValue(prefs.StandbyInstances); v != autoscaling.StandbyInstancesWait {
+		t.Errorf("standby_instances = %q, want %q", v, autoscaling.StandbyInstancesWait)
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	capacityRecommendationDefaultArchitectureType   = ec2.ArchitectureTypeX8664
+	capacityRecommendationDefaultProductDescription = ec2.RIProductDescriptionLinuxUnix
+	capacityRecommendationDefaultVirtualizationType = ec2.VirtualizationTypeHvm
+)
+
+// @SDKDataSource("aws_ec2_capacity_recommendation", name="Capacity Recommendation")
+func DataSourceCapacityRecommendation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCapacityRecommendationRead,
+
+		Schema: map[string]*schema.Schema{
+			"architecture_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.ArchitectureType_Values(), false),
+				},
+			},
+			"instance_requirements": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     instanceRequirementsSchema(),
+			},
+			"instance_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"product_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      capacityRecommendationDefaultProductDescription,
+				ValidateFunc: validation.StringInSlice(ec2.RIProductDescription_Values(), false),
+			},
+			"recommendations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"score": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"spot_price": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"spot_price_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"target_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"target_capacity_unit_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.TargetCapacityUnitType_Values(), false),
+			},
+			"virtualization_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.VirtualizationType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCapacityRecommendationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+	region := meta.(*conns.AWSClient).Region
+
+	architectureTypes := aws.StringSlice([]string{capacityRecommendationDefaultArchitectureType})
+	if v, ok := d.GetOk("architecture_types"); ok && v.(*schema.Set).Len() > 0 {
+		architectureTypes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	virtualizationTypes := aws.StringSlice([]string{capacityRecommendationDefaultVirtualizationType})
+	if v, ok := d.GetOk("virtualization_types"); ok && v.(*schema.Set).Len() > 0 {
+		virtualizationTypes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	instanceRequirements := expandInstanceRequirementsRequest(d.Get("instance_requirements").([]interface{})[0].(map[string]interface{}))
+
+	instanceTypeInfos, err := FindInstanceTypesFromInstanceRequirements(ctx, conn, &ec2.GetInstanceTypesFromInstanceRequirementsInput{
+		ArchitectureTypes:    architectureTypes,
+		InstanceRequirements: instanceRequirements,
+		VirtualizationTypes:  virtualizationTypes,
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Instance Types from instance requirements: %s", err)
+	}
+
+	if len(instanceTypeInfos) == 0 {
+		return sdkdiag.AppendErrorf(diags, "no EC2 Instance Types match the instance requirements; try different requirements")
+	}
+
+	var instanceTypes []string
+
+	for _, v := range instanceTypeInfos {
+		instanceTypes = append(instanceTypes, aws.StringValue(v.InstanceType))
+	}
+
+	sort.Strings(instanceTypes)
+
+	scoresInput := &ec2.GetSpotPlacementScoresInput{
+		InstanceRequirementsWithMetadata: &ec2.InstanceRequirementsWithMetadataRequest{
+			ArchitectureTypes:    architectureTypes,
+			InstanceRequirements: instanceRequirements,
+			VirtualizationTypes:  virtualizationTypes,
+		},
+		RegionNames:            aws.StringSlice([]string{region}),
+		SingleAvailabilityZone: aws.Bool(true),
+		TargetCapacity:         aws.Int64(int64(d.Get("target_capacity").(int))),
+	}
+
+	if v, ok := d.GetOk("target_capacity_unit_type"); ok {
+		scoresInput.TargetCapacityUnitType = aws.String(v.(string))
+	}
+
+	scores, err := FindSpotPlacementScores(ctx, conn, scoresInput)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Spot Placement Scores: %s", err)
+	}
+
+	availabilityZones, err := FindAvailabilityZones(ctx, conn, &ec2.DescribeAvailabilityZonesInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Availability Zones: %s", err)
+	}
+
+	now := time.Now()
+	prices, err := FindSpotPrices(ctx, conn, &ec2.DescribeSpotPriceHistoryInput{
+		InstanceTypes:       aws.StringSlice(instanceTypes),
+		ProductDescriptions: aws.StringSlice([]string{d.Get("product_description").(string)}),
+		StartTime:           &now,
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Spot Price History: %s", err)
+	}
+
+	d.SetId(region)
+	d.Set("instance_types", instanceTypes)
+	if err := d.Set("recommendations", flattenCapacityRecommendations(capacityRecommendations(scores, availabilityZones, prices))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting recommendations: %s", err)
+	}
+
+	return diags
+}
+
+type capacityRecommendation struct {
+	availabilityZone   string
+	availabilityZoneID string
+	instanceType       string
+	score              int64
+	spotPrice          string
+	spotPriceValue     float64
+	spotPriceTimestamp time.Time
+}
+
+// capacityRecommendations combines the per-Availability Zone Spot placement scores with the current Spot prices
+// of the matching instance types. Combinations are ranked by descending score, then by ascending Spot price.
+// Availability Zones without a score and instance types without a current price in an Availability Zone are omitted.
+func capacityRecommendations(scores []*ec2.SpotPlacementScore, availabilityZones []*ec2.AvailabilityZone, prices []*ec2.SpotPrice) []*capacityRecommendation {
+	scoresByZoneID := make(map[string]int64)
+	for _, v := range scores {
+		if v.AvailabilityZoneId != nil {
+			scoresByZoneID[aws.StringValue(v.AvailabilityZoneId)] = aws.Int64Value(v.Score)
+		}
+	}
+
+	zoneIDsByName := make(map[string]string)
+	for _, v := range availabilityZones {
+		zoneIDsByName[aws.StringValue(v.ZoneName)] = aws.StringValue(v.ZoneId)
+	}
+
+	// Keep only the most recent price for each Availability Zone and instance type.
+	latest := make(map[[2]string]*capacityRecommendation)
+
+	for _, v := range prices {
+		zoneName := aws.StringValue(v.AvailabilityZone)
+		zoneID, ok := zoneIDsByName[zoneName]
+		if !ok {
+			continue
+		}
+
+		score, ok := scoresByZoneID[zoneID]
+		if !ok {
+			continue
+		}
+
+		price, err := strconv.ParseFloat(aws.StringValue(v.SpotPrice), 64)
+		if err != nil {
+			continue
+		}
+
+		instanceType := aws.StringValue(v.InstanceType)
+		timestamp := aws.TimeValue(v.Timestamp)
+		key := [2]string{zoneName, instanceType}
+
+		if r, ok := latest[key]; ok && !timestamp.After(r.spotPriceTimestamp) {
+			continue
+		}
+
+		latest[key] = &capacityRecommendation{
+			availabilityZone:   zoneName,
+			availabilityZoneID: zoneID,
+			instanceType:       instanceType,
+			score:              score,
+			spotPrice:          aws.StringValue(v.SpotPrice),
+			spotPriceValue:     price,
+			spotPriceTimestamp: timestamp,
+		}
+	}
+
+	output := make([]*capacityRecommendation, 0, len(latest))
+	for _, v := range latest {
+		output = append(output, v)
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		a, b := output[i], output[j]
+
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		if a.spotPriceValue != b.spotPriceValue {
+			return a.spotPriceValue < b.spotPriceValue
+		}
+		if a.availabilityZone != b.availabilityZone {
+			return a.availabilityZone < b.availabilityZone
+		}
+		return a.instanceType < b.instanceType
+	})
+
+	return output
+}
+
+func flattenCapacityRecommendations(apiObjects []*capacityRecommendation) []interface{} {
+	var tfList []interface{}
+
+	for _, v := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"availability_zone":    v.availabilityZone,
+			"availability_zone_id": v.availabilityZoneID,
+			"instance_type":        v.instanceType,
+			"score":                v.score,
+			"spot_price":           v.spotPrice,
+			"spot_price_timestamp": v.spotPriceTimestamp.Format(time.RFC3339),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCapacityRecommendations(t *testing.T) {
+	t.Parallel()
+
+	older := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+
+	availabilityZones := []*ec2.AvailabilityZone{
+		{ZoneId: aws.String("use1-az1"), ZoneName: aws.String("us-east-1a")},
+		{ZoneId: aws.String("use1-az2"), ZoneName: aws.String("us-east-1b")},
+		{ZoneId: aws.String("use1-az3"), ZoneName: aws.String("us-east-1c")},
+	}
+	scores := []*ec2.SpotPlacementScore{
+		{AvailabilityZoneId: aws.String("use1-az1"), Region: aws.String("us-east-1"), Score: aws.Int64(3)},
+		{AvailabilityZoneId: aws.String("use1-az2"), Region: aws.String("us-east-1"), Score: aws.Int64(9)},
+	}
+
+	testCases := map[string]struct {
+		prices []*ec2.SpotPrice
+		want   []string
+	}{
+		"no prices": {
+			want: []string{},
+		},
+		"ranked by score then price": {
+			prices: []*ec2.SpotPrice{
+				{AvailabilityZone: aws.String("us-east-1a"), InstanceType: aws.String("m5.large"), SpotPrice: aws.String("0.0100"), Timestamp: aws.Time(newer)},
+				{AvailabilityZone: aws.String("us-east-1b"), InstanceType: aws.String("m5.large"), SpotPrice: aws.String("0.0400"), Timestamp: aws.Time(newer)},
+				{AvailabilityZone: aws.String("us-east-1b"), InstanceType: aws.String("c5.large"), SpotPrice: aws.String("0.0300"), Timestamp: aws.Time(newer)},
+				{AvailabilityZone: aws.String("us-east-1b"), InstanceType: aws.String("c5a.large"), SpotPrice: aws.String("0.0300"), Timestamp: aws.Time(newer)},
+			},
+			want: []string{"us-east-1b/c5.large/0.0300", "us-east-1b/c5a.large/0.0300", "us-east-1b/m5.large/0.0400", "us-east-1a/m5.large/0.0100"},
+		},
+		"unscored zone omitted": {
+			prices: []*ec2.SpotPrice{
+				{AvailabilityZone: aws.String("us-east-1c"), InstanceType: aws.String("m5.large"), SpotPrice: aws.String("0.0100"), Timestamp: aws.Time(newer)},
+				{AvailabilityZone: aws.String("us-east-1d"), InstanceType: aws.String("m5.large"), SpotPrice: aws.String("0.0100"), Timestamp: aws.Time(newer)},
+				{AvailabilityZone: aws.String("us-east-1a"), InstanceType: aws.String("m5.large"), SpotPrice: aws.String("0.0200"), Timestamp: aws.Time(newer)},
+			},
+			want: []string{"us-east-1a/m5.large/0.0200"},
+		},
+		"latest price kept": {
+			prices: []*ec2.SpotPrice{
+				{AvailabilityZone: aws.String("us-east-1a"), InstanceType: aws.String("m5.large"), SpotPrice: aws.String("0.0200"), Timestamp: aws.Time(newer)},
+				{AvailabilityZone: aws.String("us-east-1a"), InstanceType: aws.String("m5.large"), SpotPrice: aws.String("0.0100"), Timestamp: aws.Time(older)},
+			},
+			want: []string{"us-east-1a/m5.large/0.0200"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := []string{}
+			for _, v := range capacityRecommendations(scores, availabilityZones, testCase.prices) {
+				got = append(got, v.availabilityZone+"/"+v.instanceType+"/"+v.spotPrice)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
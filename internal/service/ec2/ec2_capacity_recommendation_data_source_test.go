@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2CapacityRecommendationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_capacity_recommendation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityRecommendationDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "instance_types.#", 0),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "recommendations.#", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, "recommendations.0.availability_zone"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "recommendations.0.availability_zone_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "recommendations.0.instance_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "recommendations.0.score"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "recommendations.0.spot_price"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "recommendations.0.spot_price_timestamp"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityRecommendationDataSource_targetCapacityUnitType(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_capacity_recommendation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityRecommendationDataSourceConfig_targetCapacityUnitType(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "instance_types.#", 0),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "recommendations.#", 0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCapacityRecommendationDataSourceConfig_basic() string {
+	return `
+data "aws_ec2_capacity_recommendation" "test" {
+  target_capacity = 2
+
+  instance_requirements {
+    memory_mib {
+      min = 4096
+      max = 8192
+    }
+
+    vcpu_count {
+      min = 2
+      max = 2
+    }
+  }
+}
+`
+}
+
+func testAccCapacityRecommendationDataSourceConfig_targetCapacityUnitType() string {
+	return `
+data "aws_ec2_capacity_recommendation" "test" {
+  architecture_types        = ["x86_64"]
+  target_capacity           = 8
+  target_capacity_unit_type = "vcpu"
+  virtualization_types      = ["hvm"]
+
+  instance_requirements {
+    burstable_performance = "excluded"
+
+    memory_mib {
+      min = 8192
+    }
+
+    vcpu_count {
+      min = 4
+      max = 8
+    }
+  }
+}
+`
+}
@@ -48,7 +48,11 @@ func ResourceInstance() *schema.Resource {
 		DeleteWithoutTimeout: resourceInstanceDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("allow_stop", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		SchemaVersion: 1,
@@ -72,6 +76,11 @@ func ResourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"allow_stop": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"associate_public_ip_address": {
 				Type:     schema.TypeBool,
 				ForceNew: true,
@@ -302,7 +311,11 @@ func ResourceInstance() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
+			},
+			"ena_support": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"enclave_options": {
 				Type:     schema.TypeList,
@@ -899,10 +912,52 @@ func ResourceInstance() *schema.Resource {
 			customdiff.ForceNewIf("user_data_base64", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.Get("user_data_replace_on_change").(bool)
 			}),
+			// EBS optimization and ENA support can only be changed while the instance is stopped.
+			customdiff.ForceNewIf("ebs_optimized", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.Id() != "" && !diff.Get("allow_stop").(bool)
+			}),
+			customdiff.ForceNewIf("ena_support", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.Id() != "" && !diff.Get("allow_stop").(bool)
+			}),
+			customizeDiffInstanceENASupport,
 		),
 	}
 }
 
+// customizeDiffInstanceENASupport fails the plan of a new instance whose ena_support differs from its AMI's
+// unless allow_stop is set, as ENA support can only be changed by stopping the instance after launch.
+func customizeDiffInstanceENASupport(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" || diff.Get("allow_stop").(bool) {
+		return nil
+	}
+
+	v := diff.GetRawConfig().GetAttr("ena_support")
+
+	if !v.IsKnown() || v.IsNull() {
+		return nil
+	}
+
+	imageID := diff.Get("ami").(string)
+
+	if imageID == "" || !diff.NewValueKnown("ami") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	image, err := FindImageByID(ctx, conn, imageID)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 AMI (%s): %w", imageID, err)
+	}
+
+	if enaSupport := aws.BoolValue(image.EnaSupport); v.True() != enaSupport {
+		return fmt.Errorf("ena_support (%t) differs from EC2 AMI (%s) ENA support (%t); set allow_stop to true to change it after launch", v.True(), imageID, enaSupport)
+	}
+
+	return nil
+}
+
 func iopsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	// Suppress diff if volume_type is not io1, io2, or gp3 and iops is unset or configured as 0
 	i := strings.LastIndexByte(k, '.')
@@ -1050,6 +1105,24 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	// ENA support is inherited from the AMI and can only be changed while the instance is stopped.
+	if v := d.GetRawConfig().GetAttr("ena_support"); v.IsKnown() && !v.IsNull() && v.True() != aws.BoolValue(instance.EnaSupport) {
+		if !d.Get("allow_stop").(bool) {
+			return sdkdiag.AppendErrorf(diags, "EC2 Instance (%s) ena_support differs from the AMI, set allow_stop to change it", d.Id())
+		}
+
+		input := &ec2.ModifyInstanceAttributeInput{
+			EnaSupport: &ec2.AttributeBooleanValue{
+				Value: aws.Bool(v.True()),
+			},
+			InstanceId: aws.String(d.Id()),
+		}
+
+		if err := modifyInstanceAttributesWithStopStart(ctx, conn, d.Id(), []*ec2.ModifyInstanceAttributeInput{input}, ""); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) ENA support: %s", d.Id(), err)
+		}
+	}
+
 	// Update if we need to
 	return append(diags, resourceInstanceUpdate(ctx, d, meta)...)
 }
@@ -1252,6 +1325,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	d.Set("ebs_optimized", instance.EbsOptimized)
+	d.Set("ena_support", instance.EnaSupport)
 	if aws.StringValue(instance.SubnetId) != "" {
 		d.Set("source_dest_check", instance.SourceDestCheck)
 	}
@@ -1654,24 +1728,23 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChanges("instance_type", "user_data", "user_data_base64") && !d.IsNewResource() {
-		// For each argument change, we start and stop the instance
-		// to account for behaviors occurring outside terraform.
-		// Only one attribute can be modified at a time, else we get
+	if d.HasChanges("instance_type", "user_data", "user_data_base64", "ebs_optimized", "ena_support") && !d.IsNewResource() {
+		// All changes are applied within a single stop/start cycle.
+		// Only one attribute can be modified per call, else we get
 		// "InvalidParameterCombination: Fields for multiple attribute types specified"
+		var inputs []*ec2.ModifyInstanceAttributeInput
+		var rollbackInstanceType string
+
 		if d.HasChange("instance_type") {
-			log.Printf("[INFO] Modifying instance type %s", d.Id())
+			o, n := d.GetChange("instance_type")
+			rollbackInstanceType = o.(string)
 
-			input := &ec2.ModifyInstanceAttributeInput{
+			inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
 				InstanceId: aws.String(d.Id()),
 				InstanceType: &ec2.AttributeValue{
-					Value: aws.String(d.Get("instance_type").(string)),
+					Value: aws.String(n.(string)),
 				},
-			}
-
-			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input); err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) type: %s", d.Id(), err)
-			}
+			})
 		}
 
 		// From the API reference:
@@ -1680,8 +1753,6 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		// Otherwise, you must provide base64-encoded text".
 
 		if d.HasChange("user_data") {
-			log.Printf("[INFO] Modifying user data %s", d.Id())
-
 			// Decode so the AWS SDK doesn't double encode
 			userData, err := base64.StdEncoding.DecodeString(d.Get("user_data").(string))
 			if err != nil {
@@ -1689,21 +1760,15 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				userData = []byte(d.Get("user_data").(string))
 			}
 
-			input := &ec2.ModifyInstanceAttributeInput{
+			inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
 				InstanceId: aws.String(d.Id()),
 				UserData: &ec2.BlobAttributeValue{
 					Value: userData,
 				},
-			}
-
-			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input); err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) user data: %s", d.Id(), err)
-			}
+			})
 		}
 
 		if d.HasChange("user_data_base64") {
-			log.Printf("[INFO] Modifying user data base64 %s", d.Id())
-
 			// Schema validation technically ensures the data is Base64 encoded.
 			// Decode so the AWS SDK doesn't double encode
 			userData, err := base64.StdEncoding.DecodeString(d.Get("user_data_base64").(string))
@@ -1712,16 +1777,37 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				userData = []byte(d.Get("user_data_base64").(string))
 			}
 
-			input := &ec2.ModifyInstanceAttributeInput{
+			inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
 				InstanceId: aws.String(d.Id()),
 				UserData: &ec2.BlobAttributeValue{
 					Value: userData,
 				},
-			}
+			})
+		}
 
-			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input); err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) user data base64: %s", d.Id(), err)
-			}
+		if d.HasChange("ebs_optimized") {
+			inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+				EbsOptimized: &ec2.AttributeBooleanValue{
+					Value: aws.Bool(d.Get("ebs_optimized").(bool)),
+				},
+				InstanceId: aws.String(d.Id()),
+			})
+		}
+
+		if d.HasChange("ena_support") {
+			inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+				EnaSupport: &ec2.AttributeBooleanValue{
+					Value: aws.Bool(d.Get("ena_support").(bool)),
+				},
+				InstanceId: aws.String(d.Id()),
+			})
+		}
+
+		if err := modifyInstanceAttributesWithStopStart(ctx, conn, d.Id(), inputs, rollbackInstanceType); err != nil {
+			// Keep the prior values in state so that the changes are planned again.
+			d.Partial(true)
+
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): %s", d.Id(), err)
 		}
 	}
 
@@ -2061,21 +2147,73 @@ func disableInstanceAPITermination(ctx context.Context, conn *ec2.EC2, id string
 	return nil
 }
 
-// modifyInstanceAttributeWithStopStart modifies a specific attribute provided
-// as input by first stopping the EC2 instance before the modification
-// and then starting up the EC2 instance after modification.
+// modifyInstanceAttributesWithStopStart applies the specified attribute modifications
+// within a single stop/start cycle of the EC2 instance. An instance that is already stopped is left stopped.
+// If the instance cannot be started because there is insufficient capacity for its new instance type,
+// the instance type is reverted to rollbackInstanceType (if set) and the instance is started again.
 // Reference: https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/Stop_Start.html
-func modifyInstanceAttributeWithStopStart(ctx context.Context, conn *ec2.EC2, input *ec2.ModifyInstanceAttributeInput) error {
-	id := aws.StringValue(input.InstanceId)
+func modifyInstanceAttributesWithStopStart(ctx context.Context, conn *ec2.EC2, id string, inputs []*ec2.ModifyInstanceAttributeInput, rollbackInstanceType string) error {
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	instance, err := FindInstanceByID(ctx, conn, id)
 
-	if err := StopInstance(ctx, conn, id, InstanceStopTimeout); err != nil {
-		return err
+	if err != nil {
+		return fmt.Errorf("reading EC2 Instance (%s): %w", id, err)
+	}
+
+	restart := aws.StringValue(instance.State.Name) != ec2.InstanceStateNameStopped
+
+	if restart {
+		if err := StopInstance(ctx, conn, id, InstanceStopTimeout); err != nil {
+			return err
+		}
+	}
+
+	for _, input := range inputs {
+		if _, err := conn.ModifyInstanceAttributeWithContext(ctx, input); err != nil {
+			return fmt.Errorf("modifying EC2 Instance (%s) attribute: %w", id, err)
+		}
+	}
+
+	if !restart {
+		return nil
 	}
 
-	if _, err := conn.ModifyInstanceAttributeWithContext(ctx, input); err != nil {
-		return fmt.Errorf("modifying EC2 Instance (%s) attribute: %w", id, err)
+	err = startModifiedInstance(ctx, conn, id, rollbackInstanceType != "")
+
+	if tfawserr.ErrCodeEquals(err, errCodeInsufficientInstanceCapacity) && rollbackInstanceType != "" {
+		log.Printf("[WARN] Insufficient capacity to start EC2 Instance (%s), reverting instance type to %s", id, rollbackInstanceType)
+
+		input := &ec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			InstanceType: &ec2.AttributeValue{
+				Value: aws.String(rollbackInstanceType),
+			},
+		}
+
+		if _, err := conn.ModifyInstanceAttributeWithContext(ctx, input); err != nil {
+			return fmt.Errorf("reverting EC2 Instance (%s) type to %s: %w", id, rollbackInstanceType, err)
+		}
+
+		if err := startModifiedInstance(ctx, conn, id, false); err != nil {
+			return err
+		}
+
+		return fmt.Errorf("instance type reverted to %s: %w", rollbackInstanceType, err)
 	}
 
+	return err
+}
+
+// startModifiedInstance starts an EC2 instance whose attributes have just been modified
+// and waits for the instance to be running.
+// If checkCapacity is set, an instance that returns to the stopped state because there is
+// insufficient capacity for its instance type fails with an InsufficientInstanceCapacity error.
+// The check is skipped when restarting after a rollback, as the instance's state reason may still
+// describe the previous attempt.
+func startModifiedInstance(ctx context.Context, conn *ec2.EC2, id string, checkCapacity bool) error {
 	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/16433.
 	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, ec2PropagationTimeout,
 		func() (interface{}, error) {
@@ -2090,7 +2228,12 @@ func modifyInstanceAttributeWithStopStart(ctx context.Context, conn *ec2.EC2, in
 		return fmt.Errorf("starting EC2 Instance (%s): %w", id, err)
 	}
 
-	if _, err := WaitInstanceStarted(ctx, conn, id, InstanceStartTimeout); err != nil {
+	wait := WaitInstanceStarted
+	if checkCapacity {
+		wait = waitInstanceStartedWithCapacityCheck
+	}
+
+	if _, err := wait(ctx, conn, id, InstanceStartTimeout); err != nil {
 		return fmt.Errorf("waiting for EC2 Instance (%s) start: %w", id, err)
 	}
 
@@ -2077,6 +2077,66 @@ func TestAccEC2Instance_changeInstanceType(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_allowStop(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after, replaced ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_allowStop(rName, "m4.large", false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "allow_stop", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_optimized", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ena_support", "true"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "m4.large"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_stop", "user_data_replace_on_change"},
+			},
+			{
+				Config: testAccInstanceConfig_allowStop(rName, "m4.xlarge", true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "ebs_optimized", "true"),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", "running"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "m4.xlarge"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_allowStop(rName, "m4.xlarge", false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &replaced),
+					testAccCheckInstanceRecreated(&after, &replaced),
+					resource.TestCheckResourceAttr(resourceName, "allow_stop", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_optimized", "false"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_allowStop(rName, "m4.large", false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&replaced, &after),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", "running"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "m4.large"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_changeInstanceTypeAndUserData(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ec2.Instance
@@ -6023,6 +6083,25 @@ resource "aws_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_allowStop(rName, instanceType string, ebsOptimized, allowStop bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  subnet_id     = aws_subnet.test.id
+  instance_type = %[2]q
+  ebs_optimized = %[3]t
+  allow_stop    = %[4]t
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, instanceType, ebsOptimized, allowStop))
+}
+
 func testAccInstanceConfig_typeAndUserData(rName, instanceType, userData string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
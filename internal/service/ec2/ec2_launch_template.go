@@ -7,11 +7,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -23,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
@@ -344,275 +347,10 @@ func ResourceLaunchTemplate() *schema.Resource {
 				},
 			},
 			"instance_requirements": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"accelerator_count": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(0),
-									},
-									"min": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"accelerator_manufacturers": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice(ec2.AcceleratorManufacturer_Values(), false),
-							},
-						},
-						"accelerator_names": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice(ec2.AcceleratorName_Values(), false),
-							},
-						},
-						"accelerator_total_memory_mib": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-									"min": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"accelerator_types": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice(ec2.AcceleratorType_Values(), false),
-							},
-						},
-						"allowed_instance_types": {
-							Type:          schema.TypeSet,
-							Optional:      true,
-							MaxItems:      400,
-							Elem:          &schema.Schema{Type: schema.TypeString},
-							ConflictsWith: []string{"instance_requirements.0.excluded_instance_types"},
-						},
-						"bare_metal": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringInSlice(ec2.BareMetal_Values(), false),
-						},
-						"baseline_ebs_bandwidth_mbps": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-									"min": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"burstable_performance": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringInSlice(ec2.BurstablePerformance_Values(), false),
-						},
-						"cpu_manufacturers": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice(ec2.CpuManufacturer_Values(), false),
-							},
-						},
-						"excluded_instance_types": {
-							Type:          schema.TypeSet,
-							Optional:      true,
-							MaxItems:      400,
-							Elem:          &schema.Schema{Type: schema.TypeString},
-							ConflictsWith: []string{"instance_requirements.0.allowed_instance_types"},
-						},
-						"instance_generations": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice(ec2.InstanceGeneration_Values(), false),
-							},
-						},
-						"local_storage": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringInSlice(ec2.LocalStorage_Values(), false),
-						},
-						"local_storage_types": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice(ec2.LocalStorageType_Values(), false),
-							},
-						},
-						"memory_gib_per_vcpu": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-									"min": {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-								},
-							},
-						},
-						"memory_mib": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-									"min": {
-										Type:         schema.TypeInt,
-										Required:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"network_bandwidth_gbps": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-									"min": {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-								},
-							},
-						},
-						"network_interface_count": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-									"min": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"on_demand_max_price_percentage_over_lowest_price": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntAtLeast(1),
-						},
-						"require_hibernate_support": {
-							Type:     schema.TypeBool,
-							Optional: true,
-						},
-						"spot_max_price_percentage_over_lowest_price": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntAtLeast(1),
-						},
-						"total_local_storage_gb": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-									"min": {
-										Type:         schema.TypeFloat,
-										Optional:     true,
-										ValidateFunc: verify.FloatGreaterThan(0.0),
-									},
-								},
-							},
-						},
-						"vcpu_count": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"max": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-									"min": {
-										Type:         schema.TypeInt,
-										Required:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-					},
-				},
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Elem:          instanceRequirementsSchema(),
 				ConflictsWith: []string{"instance_type"},
 			},
 			"instance_type": {
@@ -927,6 +665,11 @@ func ResourceLaunchTemplate() *schema.Resource {
 					},
 				},
 			},
+			"retain_versions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"update_default_version": {
@@ -952,7 +695,7 @@ func ResourceLaunchTemplate() *schema.Resource {
 			customdiff.ComputedIf("default_version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				for _, changedKey := range diff.GetChangedKeysPrefix("") {
 					switch changedKey {
-					case "name", "name_prefix", "description":
+					case "name", "name_prefix", "description", "retain_versions":
 						continue
 					default:
 						return diff.Get("update_default_version").(bool)
@@ -963,7 +706,7 @@ func ResourceLaunchTemplate() *schema.Resource {
 			customdiff.ComputedIf("latest_version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				for _, changedKey := range diff.GetChangedKeysPrefix("") {
 					switch changedKey {
-					case "name", "name_prefix", "description", "default_version", "update_default_version":
+					case "name", "name_prefix", "description", "default_version", "retain_versions", "update_default_version":
 						continue
 					default:
 						return true
@@ -976,6 +719,276 @@ func ResourceLaunchTemplate() *schema.Resource {
 	}
 }
 
+// instanceRequirementsSchema returns the schema of an instance_requirements block.
+func instanceRequirementsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"accelerator_count": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"accelerator_manufacturers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.AcceleratorManufacturer_Values(), false),
+				},
+			},
+			"accelerator_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.AcceleratorName_Values(), false),
+				},
+			},
+			"accelerator_total_memory_mib": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"accelerator_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.AcceleratorType_Values(), false),
+				},
+			},
+			"allowed_instance_types": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      400,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"instance_requirements.0.excluded_instance_types"},
+			},
+			"bare_metal": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.BareMetal_Values(), false),
+			},
+			"baseline_ebs_bandwidth_mbps": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"burstable_performance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.BurstablePerformance_Values(), false),
+			},
+			"cpu_manufacturers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.CpuManufacturer_Values(), false),
+				},
+			},
+			"excluded_instance_types": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      400,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"instance_requirements.0.allowed_instance_types"},
+			},
+			"instance_generations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.InstanceGeneration_Values(), false),
+				},
+			},
+			"local_storage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.LocalStorage_Values(), false),
+			},
+			"local_storage_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.LocalStorageType_Values(), false),
+				},
+			},
+			"memory_gib_per_vcpu": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: verify.FloatGreaterThan(0.0),
+						},
+						"min": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: verify.FloatGreaterThan(0.0),
+						},
+					},
+				},
+			},
+			"memory_mib": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"network_bandwidth_gbps": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: verify.FloatGreaterThan(0.0),
+						},
+						"min": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: verify.FloatGreaterThan(0.0),
+						},
+					},
+				},
+			},
+			"network_interface_count": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"on_demand_max_price_percentage_over_lowest_price": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"require_hibernate_support": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"spot_max_price_percentage_over_lowest_price": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"total_local_storage_gb": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: verify.FloatGreaterThan(0.0),
+						},
+						"min": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: verify.FloatGreaterThan(0.0),
+						},
+					},
+				},
+			},
+			"vcpu_count": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceLaunchTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
@@ -1136,6 +1149,12 @@ func resourceLaunchTemplateUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if v, ok := d.GetOk("retain_versions"); ok && (d.HasChanges(updateKeys...) || d.HasChange("retain_versions")) {
+		if err := pruneLaunchTemplateVersions(ctx, conn, meta.(*conns.AWSClient).AutoScalingConn(ctx), d.Id(), v.(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting EC2 Launch Template (%s) old versions: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceLaunchTemplateRead(ctx, d, meta)...)
 }
 
@@ -1159,6 +1178,154 @@ func resourceLaunchTemplateDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// pruneLaunchTemplateVersions deletes all but the most recent retain versions of a launch template.
+// The default version and versions referenced by Auto Scaling groups or their instances are never deleted.
+// Versions managed by aws_launch_template_version cannot be identified and are not protected.
+func pruneLaunchTemplateVersions(ctx context.Context, conn *ec2.EC2, autoscalingConn *autoscaling.AutoScaling, id string, retain int) error {
+	lt, err := FindLaunchTemplateByID(ctx, conn, id)
+
+	if err != nil {
+		return err
+	}
+
+	ltvs, err := FindLaunchTemplateVersions(ctx, conn, &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(id),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	inUse, err := findAutoScalingGroupsLaunchTemplateVersions(ctx, autoscalingConn, lt)
+
+	if err != nil {
+		return fmt.Errorf("reading Auto Scaling Groups: %w", err)
+	}
+
+	inUse[aws.Int64Value(lt.DefaultVersionNumber)] = true
+
+	var versions []int64
+	for _, v := range ltvs {
+		versions = append(versions, aws.Int64Value(v.VersionNumber))
+	}
+
+	for _, chunk := range tfslices.Chunks(launchTemplateVersionsToDelete(versions, retain, inUse), launchTemplateVersionsDeleteBatchSize) {
+		if err := deleteLaunchTemplateVersions(ctx, conn, id, chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findAutoScalingGroupsLaunchTemplateVersions returns the versions of the specified launch template
+// that are referenced by Auto Scaling groups, either directly, through a mixed instances policy,
+// or by the group's instances.
+func findAutoScalingGroupsLaunchTemplateVersions(ctx context.Context, conn *autoscaling.AutoScaling, lt *ec2.LaunchTemplate) (map[int64]bool, error) {
+	output := make(map[int64]bool)
+
+	add := func(apiObject *autoscaling.LaunchTemplateSpecification) {
+		if apiObject == nil {
+			return
+		}
+
+		if id, name := aws.StringValue(apiObject.LaunchTemplateId), aws.StringValue(apiObject.LaunchTemplateName); id != aws.StringValue(lt.LaunchTemplateId) && name != aws.StringValue(lt.LaunchTemplateName) {
+			return
+		}
+
+		switch version := aws.StringValue(apiObject.Version); version {
+		case LaunchTemplateVersionDefault, "":
+			output[aws.Int64Value(lt.DefaultVersionNumber)] = true
+		case LaunchTemplateVersionLatest:
+			output[aws.Int64Value(lt.LatestVersionNumber)] = true
+		default:
+			if v, err := strconv.ParseInt(version, 10, 64); err == nil {
+				output[v] = true
+			}
+		}
+	}
+
+	err := conn.DescribeAutoScalingGroupsPagesWithContext(ctx, &autoscaling.DescribeAutoScalingGroupsInput{}, func(page *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, group := range page.AutoScalingGroups {
+			if group == nil {
+				continue
+			}
+
+			add(group.LaunchTemplate)
+
+			if v := group.MixedInstancesPolicy; v != nil && v.LaunchTemplate != nil {
+				add(v.LaunchTemplate.LaunchTemplateSpecification)
+
+				for _, v := range v.LaunchTemplate.Overrides {
+					if v != nil {
+						add(v.LaunchTemplateSpecification)
+					}
+				}
+			}
+
+			for _, v := range group.Instances {
+				if v != nil {
+					add(v.LaunchTemplate)
+				}
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// launchTemplateVersionsToDelete returns the versions outside of the retain most recent, skipping those in use.
+func launchTemplateVersionsToDelete(versions []int64, retain int, inUse map[int64]bool) []int64 {
+	versions = append([]int64(nil), versions...)
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i] > versions[j]
+	})
+
+	var output []int64
+
+	for i, v := range versions {
+		if i < retain || inUse[v] {
+			continue
+		}
+
+		output = append(output, v)
+	}
+
+	return output
+}
+
+// DeleteLaunchTemplateVersions accepts at most 200 versions per request.
+const launchTemplateVersionsDeleteBatchSize = 200
+
+func deleteLaunchTemplateVersions(ctx context.Context, conn *ec2.EC2, id string, versions []int64) error {
+	input := &ec2.DeleteLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(id),
+	}
+
+	for _, v := range versions {
+		input.Versions = append(input.Versions, aws.String(strconv.FormatInt(v, 10)))
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Launch Template (%s) Versions: %s", id, aws.StringValueSlice(input.Versions))
+	output, err := conn.DeleteLaunchTemplateVersionsWithContext(ctx, input)
+
+	if err == nil && output != nil {
+		err = DeleteLaunchTemplateVersionsError(output.UnsuccessfullyDeletedLaunchTemplateVersions)
+	}
+
+	return err
+}
+
 func expandRequestLaunchTemplateData(ctx context.Context, conn *ec2.EC2, d *schema.ResourceData) (*ec2.RequestLaunchTemplateData, error) {
 	apiObject := &ec2.RequestLaunchTemplateData{
 		// Always set at least one field.
@@ -6,10 +6,13 @@ package ec2_test
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -510,6 +513,57 @@ func TestAccEC2LaunchTemplate_description(t *testing.T) {
 	})
 }
 
+func TestAccEC2LaunchTemplate_retainVersions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template ec2.LaunchTemplate
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_retainVersions(rName, "t3.micro", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "retain_versions", "2"),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_retainVersions(rName, "t3.small", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					testAccCheckLaunchTemplateVersions(ctx, resourceName, 1, 2),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_retainVersions(rName, "t3.medium", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "3"),
+					testAccCheckLaunchTemplateVersions(ctx, resourceName, 2, 3),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_retainVersions(rName, "t3.medium", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					testAccCheckLaunchTemplateVersions(ctx, resourceName, 3),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_versions", "update_default_version"},
+			},
+		},
+	})
+}
+
 func TestAccEC2LaunchTemplate_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	var template ec2.LaunchTemplate
@@ -3207,6 +3261,37 @@ func testAccCheckLaunchTemplateExists(ctx context.Context, n string, v *ec2.Laun
 	}
 }
 
+func testAccCheckLaunchTemplateVersions(ctx context.Context, n string, want ...int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindLaunchTemplateVersions(ctx, conn, &ec2.DescribeLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		var got []int64
+		for _, v := range output {
+			got = append(got, aws.Int64Value(v.VersionNumber))
+		}
+		sort.Slice(got, func(i, j int) bool { return got[i] < got[j] })
+
+		if !reflect.DeepEqual(got, want) {
+			return fmt.Errorf("EC2 Launch Template (%s) versions = %v, want %v", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckLaunchTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
@@ -3628,6 +3713,17 @@ resource "aws_launch_template" "test" {
 `, rName)
 }
 
+func testAccLaunchTemplateConfig_retainVersions(rName, instanceType string, retain int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name                   = %[1]q
+  instance_type          = %[2]q
+  retain_versions        = %[3]d
+  update_default_version = true
+}
+`, rName, instanceType, retain)
+}
+
 func testAccLaunchTemplateConfig_description(rName, description string) string {
 	return fmt.Sprintf(`
 resource "aws_launch_template" "test" {
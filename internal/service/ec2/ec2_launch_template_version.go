@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_launch_template_version", name="Launch Template Version")
+func ResourceLaunchTemplateVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLaunchTemplateVersionCreate,
+		ReadWithoutTimeout:   resourceLaunchTemplateVersionRead,
+		UpdateWithoutTimeout: resourceLaunchTemplateVersionUpdate,
+		DeleteWithoutTimeout: resourceLaunchTemplateVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: launchTemplateVersionSchema(),
+	}
+}
+
+// launchTemplateVersionSchema returns the launch template data arguments of aws_launch_template
+// together with the version's own arguments. Versions are immutable, so every data argument forces replacement.
+func launchTemplateVersionSchema() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"launch_template_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"set_default_version": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"version_number": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+
+	for k, v := range ResourceLaunchTemplate().Schema {
+		switch k {
+		case "arn", "default_version", "latest_version", "name", "name_prefix", "retain_versions", names.AttrTags, names.AttrTagsAll, "update_default_version":
+			continue
+		}
+
+		s[k] = forceNewSchema(v)
+	}
+
+	return s
+}
+
+// forceNewSchema returns a copy of the specified schema with all configurable attributes, including nested ones, forcing replacement.
+func forceNewSchema(v *schema.Schema) *schema.Schema {
+	c := *v
+
+	if c.Optional || c.Required {
+		c.ForceNew = true
+	}
+
+	if elem, ok := c.Elem.(*schema.Resource); ok {
+		r := *elem
+		r.Schema = make(map[string]*schema.Schema, len(elem.Schema))
+
+		for k, v := range elem.Schema {
+			r.Schema[k] = forceNewSchema(v)
+		}
+
+		c.Elem = &r
+	}
+
+	return &c
+}
+
+func resourceLaunchTemplateVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	launchTemplateID := d.Get("launch_template_id").(string)
+	input := &ec2.CreateLaunchTemplateVersionInput{
+		ClientToken:      aws.String(id.UniqueId()),
+		LaunchTemplateId: aws.String(launchTemplateID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.VersionDescription = aws.String(v.(string))
+	}
+
+	if v, err := expandRequestLaunchTemplateData(ctx, conn, d); err == nil {
+		input.LaunchTemplateData = v
+	} else {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := conn.CreateLaunchTemplateVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Launch Template (%s) Version: %s", launchTemplateID, err)
+	}
+
+	version := strconv.FormatInt(aws.Int64Value(output.LaunchTemplateVersion.VersionNumber), 10)
+	d.SetId(LaunchTemplateVersionCreateResourceID(launchTemplateID, version))
+
+	if d.Get("set_default_version").(bool) {
+		if err := setLaunchTemplateDefaultVersion(ctx, conn, launchTemplateID, version); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceLaunchTemplateVersionRead(ctx, d, meta)...)
+}
+
+func resourceLaunchTemplateVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	launchTemplateID, version, err := LaunchTemplateVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	ltv, err := FindLaunchTemplateVersionByTwoPartKey(ctx, conn, launchTemplateID, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Launch Template Version %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Launch Template Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", ltv.VersionDescription)
+	d.Set("launch_template_id", ltv.LaunchTemplateId)
+	// Only detect drift when this version is expected to be the default.
+	if d.Get("set_default_version").(bool) {
+		d.Set("set_default_version", ltv.DefaultVersion)
+	}
+	d.Set("version_number", ltv.VersionNumber)
+
+	if err := flattenResponseLaunchTemplateData(ctx, conn, d, ltv.LaunchTemplateData); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceLaunchTemplateVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	if d.HasChange("set_default_version") && d.Get("set_default_version").(bool) {
+		launchTemplateID, version, err := LaunchTemplateVersionParseResourceID(d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := setLaunchTemplateDefaultVersion(ctx, conn, launchTemplateID, version); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceLaunchTemplateVersionRead(ctx, d, meta)...)
+}
+
+func resourceLaunchTemplateVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	launchTemplateID, version, err := LaunchTemplateVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	ltv, err := FindLaunchTemplateVersionByTwoPartKey(ctx, conn, launchTemplateID, version)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Launch Template Version (%s): %s", d.Id(), err)
+	}
+
+	// The default version can only be deleted together with the launch template.
+	if aws.BoolValue(ltv.DefaultVersion) {
+		return sdkdiag.AppendWarningf(diags, "EC2 Launch Template Version (%s) is the default version and was not deleted", d.Id())
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Launch Template Version: %s", d.Id())
+	err = deleteLaunchTemplateVersions(ctx, conn, launchTemplateID, []int64{aws.Int64Value(ltv.VersionNumber)})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidLaunchTemplateIdNotFound, errCodeInvalidLaunchTemplateIdVersionNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Launch Template Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func setLaunchTemplateDefaultVersion(ctx context.Context, conn *ec2.EC2, launchTemplateID, version string) error {
+	input := &ec2.ModifyLaunchTemplateInput{
+		DefaultVersion:   aws.String(version),
+		LaunchTemplateId: aws.String(launchTemplateID),
+	}
+
+	if _, err := conn.ModifyLaunchTemplateWithContext(ctx, input); err != nil {
+		return fmt.Errorf("setting EC2 Launch Template (%s) default version (%s): %w", launchTemplateID, version, err)
+	}
+
+	return nil
+}
+
+const launchTemplateVersionIDSeparator = ","
+
+func LaunchTemplateVersionCreateResourceID(launchTemplateID, version string) string {
+	parts := []string{launchTemplateID, version}
+	id := strings.Join(parts, launchTemplateVersionIDSeparator)
+
+	return id
+}
+
+func LaunchTemplateVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, launchTemplateVersionIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected LAUNCH_TEMPLATE_ID%[2]sVERSION", id, launchTemplateVersionIDSeparator)
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_launch_template_version", name="Launch Template Version")
+func DataSourceLaunchTemplateVersion() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLaunchTemplateVersionRead,
+
+		Schema: map[string]*schema.Schema{
+			"changed_attributes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"compare_to_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"compare_to_version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"default_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"latest_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"launch_template_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"launch_template_id", "launch_template_name"},
+			},
+			"launch_template_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  LaunchTemplateVersionLatest,
+			},
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLaunchTemplateVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.DescribeLaunchTemplatesInput{}
+
+	if v, ok := d.GetOk("launch_template_id"); ok {
+		input.LaunchTemplateIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	if v, ok := d.GetOk("launch_template_name"); ok {
+		input.LaunchTemplateNames = aws.StringSlice([]string{v.(string)})
+	}
+
+	lt, err := FindLaunchTemplate(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Launch Template", err))
+	}
+
+	launchTemplateID := aws.StringValue(lt.LaunchTemplateId)
+	version := d.Get("version").(string)
+	ltv, err := FindLaunchTemplateVersionByTwoPartKey(ctx, conn, launchTemplateID, version)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Launch Template (%s) Version (%s): %s", launchTemplateID, version, err)
+	}
+
+	d.SetId(LaunchTemplateVersionCreateResourceID(launchTemplateID, strconv.FormatInt(aws.Int64Value(ltv.VersionNumber), 10)))
+	d.Set("default_version", lt.DefaultVersionNumber)
+	d.Set("description", ltv.VersionDescription)
+	d.Set("latest_version", lt.LatestVersionNumber)
+	d.Set("launch_template_id", lt.LaunchTemplateId)
+	d.Set("launch_template_name", lt.LaunchTemplateName)
+	d.Set("version_number", ltv.VersionNumber)
+
+	if v, ok := d.GetOk("compare_to_version"); ok {
+		compareTo := v.(string)
+		other, err := FindLaunchTemplateVersionByTwoPartKey(ctx, conn, launchTemplateID, compareTo)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Launch Template (%s) Version (%s): %s", launchTemplateID, compareTo, err)
+		}
+
+		d.Set("changed_attributes", launchTemplateDataChangedAttributes(other.LaunchTemplateData, ltv.LaunchTemplateData))
+		d.Set("compare_to_version_number", other.VersionNumber)
+	} else {
+		d.Set("changed_attributes", nil)
+		d.Set("compare_to_version_number", nil)
+	}
+
+	return diags
+}
+
+// launchTemplateDataAttributeNames maps launch template data API fields to aws_launch_template argument names.
+var launchTemplateDataAttributeNames = map[string]string{
+	"BlockDeviceMappings":               "block_device_mappings",
+	"CapacityReservationSpecification":  "capacity_reservation_specification",
+	"CpuOptions":                        "cpu_options",
+	"CreditSpecification":               "credit_specification",
+	"DisableApiStop":                    "disable_api_stop",
+	"DisableApiTermination":             "disable_api_termination",
+	"EbsOptimized":                      "ebs_optimized",
+	"ElasticGpuSpecifications":          "elastic_gpu_specifications",
+	"ElasticInferenceAccelerators":      "elastic_inference_accelerator",
+	"EnclaveOptions":                    "enclave_options",
+	"HibernationOptions":                "hibernation_options",
+	"IamInstanceProfile":                "iam_instance_profile",
+	"ImageId":                           "image_id",
+	"InstanceInitiatedShutdownBehavior": "instance_initiated_shutdown_behavior",
+	"InstanceMarketOptions":             "instance_market_options",
+	"InstanceRequirements":              "instance_requirements",
+	"InstanceType":                      "instance_type",
+	"KernelId":                          "kernel_id",
+	"KeyName":                           "key_name",
+	"LicenseSpecifications":             "license_specification",
+	"MaintenanceOptions":                "maintenance_options",
+	"MetadataOptions":                   "metadata_options",
+	"Monitoring":                        "monitoring",
+	"NetworkInterfaces":                 "network_interfaces",
+	"Placement":                         "placement",
+	"PrivateDnsNameOptions":             "private_dns_name_options",
+	"RamDiskId":                         "ram_disk_id",
+	"SecurityGroupIds":                  "vpc_security_group_ids",
+	"SecurityGroups":                    "security_group_names",
+	"TagSpecifications":                 "tag_specifications",
+	"UserData":                          "user_data",
+}
+
+// launchTemplateDataChangedAttributes returns the sorted names of the launch template arguments that differ between old and new.
+// Fields without a corresponding argument are reported by their API name.
+func launchTemplateDataChangedAttributes(old, new *ec2.ResponseLaunchTemplateData) []string {
+	if old == nil {
+		old = &ec2.ResponseLaunchTemplateData{}
+	}
+	if new == nil {
+		new = &ec2.ResponseLaunchTemplateData{}
+	}
+
+	var output []string
+
+	o, n := reflect.ValueOf(old).Elem(), reflect.ValueOf(new).Elem()
+
+	for i := 0; i < o.NumField(); i++ {
+		field := o.Type().Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if launchTemplateDataFieldEqual(o.Field(i), n.Field(i)) {
+			continue
+		}
+
+		name, ok := launchTemplateDataAttributeNames[field.Name]
+		if !ok {
+			name = field.Name
+		}
+
+		output = append(output, name)
+	}
+
+	sort.Strings(output)
+
+	return output
+}
+
+// launchTemplateDataFieldEqual reports whether two launch template data fields are equal.
+// List-valued fields are compared without regard to element order.
+func launchTemplateDataFieldEqual(o, n reflect.Value) bool {
+	if o.Kind() != reflect.Slice {
+		return reflect.DeepEqual(o.Interface(), n.Interface())
+	}
+
+	if o.Len() != n.Len() {
+		return false
+	}
+
+	matched := make([]bool, n.Len())
+
+	for i := 0; i < o.Len(); i++ {
+		found := false
+
+		for j := 0; j < n.Len(); j++ {
+			if !matched[j] && reflect.DeepEqual(o.Index(i).Interface(), n.Index(j).Interface()) {
+				matched[j], found = true, true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2LaunchTemplateVersionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_launch_template_version.test"
+	resourceName := "aws_launch_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionDataSourceConfig_basic(rName, "t3.micro"),
+			},
+			{
+				Config: testAccLaunchTemplateVersionDataSourceConfig_basic(rName, "t3.small"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "changed_attributes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "changed_attributes.0", "instance_type"),
+					resource.TestCheckResourceAttr(dataSourceName, "compare_to_version_number", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "default_version", resourceName, "default_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "latest_version", resourceName, "latest_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "launch_template_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "launch_template_name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "version_number", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLaunchTemplateVersionDataSourceConfig_basic(rName, instanceType string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name                   = %[1]q
+  instance_type          = %[2]q
+  update_default_version = false
+}
+
+data "aws_launch_template_version" "test" {
+  launch_template_name = aws_launch_template.test.name
+  version              = "$Latest"
+  compare_to_version   = "$Default"
+
+  depends_on = [aws_launch_template.test]
+}
+`, rName, instanceType)
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLaunchTemplateVersionsToDelete(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		versions []int64
+		retain   int
+		inUse    map[int64]bool
+		want     []int64
+	}{
+		"fewer than retained": {
+			versions: []int64{1, 2},
+			retain:   3,
+		},
+		"oldest deleted": {
+			versions: []int64{3, 1, 5, 2, 4},
+			retain:   2,
+			want:     []int64{3, 2, 1},
+		},
+		"in use kept": {
+			versions: []int64{1, 2, 3, 4, 5},
+			retain:   1,
+			inUse:    map[int64]bool{1: true, 3: true},
+			want:     []int64{4, 2},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := launchTemplateVersionsToDelete(testCase.versions, testCase.retain, testCase.inUse)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLaunchTemplateDataChangedAttributes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old, new *ec2.ResponseLaunchTemplateData
+		want     []string
+	}{
+		"identical": {
+			old: &ec2.ResponseLaunchTemplateData{InstanceType: aws.String("t3.micro")},
+			new: &ec2.ResponseLaunchTemplateData{InstanceType: aws.String("t3.micro")},
+		},
+		"scalar and nested": {
+			old: &ec2.ResponseLaunchTemplateData{
+				ImageId:          aws.String("ami-1"),
+				InstanceType:     aws.String("t3.micro"),
+				SecurityGroupIds: aws.StringSlice([]string{"sg-1"}),
+			},
+			new: &ec2.ResponseLaunchTemplateData{
+				ImageId:          aws.String("ami-2"),
+				InstanceType:     aws.String("t3.micro"),
+				MetadataOptions:  &ec2.LaunchTemplateInstanceMetadataOptions{HttpTokens: aws.String("required")},
+				SecurityGroupIds: aws.StringSlice([]string{"sg-1", "sg-2"}),
+			},
+			want: []string{"image_id", "metadata_options", "vpc_security_group_ids"},
+		},
+		"reordered lists": {
+			old: &ec2.ResponseLaunchTemplateData{
+				SecurityGroupIds: aws.StringSlice([]string{"sg-1", "sg-2"}),
+				TagSpecifications: []*ec2.LaunchTemplateTagSpecification{
+					{ResourceType: aws.String("instance")},
+					{ResourceType: aws.String("volume")},
+				},
+			},
+			new: &ec2.ResponseLaunchTemplateData{
+				SecurityGroupIds: aws.StringSlice([]string{"sg-2", "sg-1"}),
+				TagSpecifications: []*ec2.LaunchTemplateTagSpecification{
+					{ResourceType: aws.String("volume")},
+					{ResourceType: aws.String("instance")},
+				},
+			},
+		},
+		"duplicate list elements": {
+			old:  &ec2.ResponseLaunchTemplateData{SecurityGroupIds: aws.StringSlice([]string{"sg-1", "sg-1"})},
+			new:  &ec2.ResponseLaunchTemplateData{SecurityGroupIds: aws.StringSlice([]string{"sg-1", "sg-2"})},
+			want: []string{"vpc_security_group_ids"},
+		},
+		"missing": {
+			new:  &ec2.ResponseLaunchTemplateData{UserData: aws.String("ZWNobw==")},
+			want: []string{"user_data"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := launchTemplateDataChangedAttributes(testCase.old, testCase.new)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2LaunchTemplateVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.LaunchTemplateVersion
+	resourceName := "aws_launch_template_version.test"
+	templateResourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionConfig_basic(rName, "t3.small", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t3.small"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_id", templateResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "set_default_version", "false"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"set_default_version"},
+			},
+			{
+				Config: testAccLaunchTemplateVersionConfig_basic(rName, "t3.small", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "set_default_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateVersionConfig_basic(rName, "t3.medium", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t3.medium"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.LaunchTemplateVersion
+	resourceName := "aws_launch_template_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionConfig_basic(rName, "t3.small", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceLaunchTemplateVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLaunchTemplateVersionExists(ctx context.Context, n string, v *ec2.LaunchTemplateVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Launch Template Version ID is set")
+		}
+
+		launchTemplateID, version, err := tfec2.LaunchTemplateVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindLaunchTemplateVersionByTwoPartKey(ctx, conn, launchTemplateID, version)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLaunchTemplateVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_launch_template_version" {
+				continue
+			}
+
+			launchTemplateID, version, err := tfec2.LaunchTemplateVersionParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfec2.FindLaunchTemplateVersionByTwoPartKey(ctx, conn, launchTemplateID, version)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Launch Template Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccLaunchTemplateVersionConfig_basic(rName, instanceType string, setDefault bool) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = "t3.micro"
+
+  # The template reads its arguments from the latest version.
+  lifecycle {
+    ignore_changes = all
+  }
+}
+
+resource "aws_launch_template_version" "test" {
+  launch_template_id  = aws_launch_template.test.id
+  description         = %[1]q
+  instance_type       = %[2]q
+  set_default_version = %[3]t
+}
+`, rName, instanceType, setDefault)
+}
@@ -112,6 +112,7 @@ const (
 	errCodeOperationNotPermitted                             = "OperationNotPermitted"
 	errCodePrefixListVersionMismatch                         = "PrefixListVersionMismatch"
 	errCodeResourceNotReady                                  = "ResourceNotReady"
+	errCodeServerInsufficientInstanceCapacity                = "Server.InsufficientInstanceCapacity"
 	errCodeSnapshotCreationPerVolumeRateExceeded             = "SnapshotCreationPerVolumeRateExceeded"
 	errCodeUnsupportedOperation                              = "UnsupportedOperation"
 	errCodeVolumeInUse                                       = "VolumeInUse"
@@ -139,6 +140,26 @@ func CancelSpotFleetRequestsError(apiObjects []*ec2.CancelSpotFleetRequestsError
 	return errors.ErrorOrNil()
 }
 
+func DeleteLaunchTemplateVersionError(apiObject *ec2.DeleteLaunchTemplateVersionsResponseErrorItem) error {
+	if apiObject == nil || apiObject.ResponseError == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.ResponseError.Code), aws.StringValue(apiObject.ResponseError.Message), nil)
+}
+
+func DeleteLaunchTemplateVersionsError(apiObjects []*ec2.DeleteLaunchTemplateVersionsResponseErrorItem) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if err := DeleteLaunchTemplateVersionError(apiObject); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("%d: %w", aws.Int64Value(apiObject.VersionNumber), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
 func DeleteFleetError(apiObject *ec2.DeleteFleetErrorItem) error {
 	if apiObject == nil || apiObject.Error == nil {
 		return nil
@@ -41,7 +41,7 @@ func (p *servicePackage) CustomizeConn(ctx context.Context, conn *ec2_sdkv1.EC2)
 				r.Retryable = aws_sdkv1.Bool(true)
 			}
 
-		case "RunInstances":
+		case "RunInstances", "StartInstances":
 			// `InsufficientInstanceCapacity` error has status code 500 and AWS SDK try retry this error by default.
 			if tfawserr.ErrCodeEquals(err, errCodeInsufficientInstanceCapacity) {
 				r.Retryable = aws_sdkv1.Bool(false)
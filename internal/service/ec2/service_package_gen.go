@@ -100,6 +100,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceEBSVolumes,
 			TypeName: "aws_ebs_volumes",
 		},
+		{
+			Factory:  DataSourceCapacityRecommendation,
+			TypeName: "aws_ec2_capacity_recommendation",
+			Name:     "Capacity Recommendation",
+		},
 		{
 			Factory:  DataSourceClientVPNEndpoint,
 			TypeName: "aws_ec2_client_vpn_endpoint",
@@ -280,6 +285,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceLaunchTemplate,
 			TypeName: "aws_launch_template",
 		},
+		{
+			Factory:  DataSourceLaunchTemplateVersion,
+			TypeName: "aws_launch_template_version",
+			Name:     "Launch Template Version",
+		},
 		{
 			Factory:  DataSourceNATGateway,
 			TypeName: "aws_nat_gateway",
@@ -372,6 +382,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceVPCPeeringConnections,
 			TypeName: "aws_vpc_peering_connections",
 		},
+		{
+			Factory:  DataSourceReachabilityCheck,
+			TypeName: "aws_vpc_reachability_check",
+		},
+		{
+			Factory:  DataSourceVPCSubnetPlan,
+			TypeName: "aws_vpc_subnet_plan",
+		},
 		{
 			Factory:  DataSourceVPCs,
 			TypeName: "aws_vpcs",
@@ -825,6 +843,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceLaunchTemplateVersion,
+			TypeName: "aws_launch_template_version",
+			Name:     "Launch Template Version",
+		},
 		{
 			Factory:  ResourceMainRouteTableAssociation,
 			TypeName: "aws_main_route_table_association",
@@ -1093,6 +1116,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceVPCPeeringConnectionOptions,
 			TypeName: "aws_vpc_peering_connection_options",
 		},
+		{
+			Factory:  ResourceSecurityGroupRulesExclusive,
+			TypeName: "aws_vpc_security_group_rules_exclusive",
+		},
 		{
 			Factory:  ResourceVPNConnection,
 			TypeName: "aws_vpn_connection",
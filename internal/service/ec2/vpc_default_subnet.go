@@ -57,6 +57,8 @@ func ResourceDefaultSubnet() *schema.Resource {
 		// and additions:
 		//   - existing_default_subnet Computed-only, set in resourceDefaultSubnetCreate
 		//   - force_destroy Optional
+		// and removals:
+		//   - ipv4_ipam_pool_id and ipv4_netmask_length, as default subnets are not allocated from IPAM pools
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
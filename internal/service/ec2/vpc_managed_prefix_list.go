@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -22,6 +23,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// CreateManagedPrefixList and ModifyManagedPrefixList accept at most 100 entries per request.
+	managedPrefixListModifyEntriesMax = 100
+)
+
 // @SDKResource("aws_ec2_managed_prefix_list", name="Managed Prefix List")
 // @Tags(identifierAttribute="id")
 func ResourceManagedPrefixList() *schema.Resource {
@@ -107,8 +113,18 @@ func resourceManagedPrefixListCreate(ctx context.Context, d *schema.ResourceData
 		input.AddressFamily = aws.String(v.(string))
 	}
 
+	var addEntries []*ec2.AddPrefixListEntry
+
 	if v, ok := d.GetOk("entry"); ok && v.(*schema.Set).Len() > 0 {
-		input.Entries = expandAddPrefixListEntries(v.(*schema.Set).List())
+		addEntries = expandAddPrefixListEntries(v.(*schema.Set).List())
+
+		// CreateManagedPrefixList accepts at most 100 entries. Add the remainder once the prefix list is created.
+		n := len(addEntries)
+		if n > managedPrefixListModifyEntriesMax {
+			n = managedPrefixListModifyEntriesMax
+		}
+
+		input.Entries, addEntries = addEntries[:n], addEntries[n:]
 	}
 
 	if v, ok := d.GetOk("max_entries"); ok {
@@ -128,10 +144,18 @@ func resourceManagedPrefixListCreate(ctx context.Context, d *schema.ResourceData
 
 	d.SetId(aws.StringValue(output.PrefixList.PrefixListId))
 
-	if _, err := WaitManagedPrefixListCreated(ctx, conn, d.Id()); err != nil {
+	pl, err := WaitManagedPrefixListCreated(ctx, conn, d.Id())
+
+	if err != nil {
 		return diag.Errorf("waiting for EC2 Managed Prefix List (%s) create: %s", d.Id(), err)
 	}
 
+	if len(addEntries) > 0 {
+		if err := modifyManagedPrefixListEntries(ctx, conn, d.Id(), pl.Version, addEntries, nil, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("creating EC2 Managed Prefix List (%s): %s", d.Id(), err)
+		}
+	}
+
 	return resourceManagedPrefixListRead(ctx, d, meta)
 }
 
@@ -179,6 +203,7 @@ func resourceManagedPrefixListUpdate(ctx context.Context, d *schema.ResourceData
 	//   If MaxEntry is decreasing, complete after updating entry(s)
 	maxEntryChangedDecrease := false
 	var newMaxEntryInt int64
+	currentVersion := aws.Int64(int64(d.Get("version").(int)))
 
 	if d.HasChange("max_entries") {
 		oldMaxEntry, newMaxEntry := d.GetChange("max_entries")
@@ -187,111 +212,41 @@ func resourceManagedPrefixListUpdate(ctx context.Context, d *schema.ResourceData
 		if newMaxEntry.(int) < oldMaxEntry.(int) {
 			maxEntryChangedDecrease = true
 		} else {
-			err := updateMaxEntry(ctx, conn, d.Id(), newMaxEntryInt)
+			version, err := updateMaxEntry(ctx, conn, d.Id(), newMaxEntryInt)
 			if err != nil {
 				return diag.Errorf("updating EC2 Managed Prefix List (%s) increased MaxEntries : %s", d.Id(), err)
 			}
+			currentVersion = version
 		}
 	}
 
-	if d.HasChangesExcept("tags", "tags_all", "max_entries") {
+	if d.HasChange("name") {
 		input := &ec2.ModifyManagedPrefixListInput{
-			PrefixListId: aws.String(d.Id()),
-		}
-
-		input.PrefixListName = aws.String(d.Get("name").(string))
-		currentVersion := int64(d.Get("version").(int))
-		wait := false
-
-		oldAttr, newAttr := d.GetChange("entry")
-		os := oldAttr.(*schema.Set)
-		ns := newAttr.(*schema.Set)
-
-		if addEntries := ns.Difference(os); addEntries.Len() > 0 {
-			input.AddEntries = expandAddPrefixListEntries(addEntries.List())
-			input.CurrentVersion = aws.Int64(currentVersion)
-			wait = true
-		}
-
-		if removeEntries := os.Difference(ns); removeEntries.Len() > 0 {
-			input.RemoveEntries = expandRemovePrefixListEntries(removeEntries.List())
-			input.CurrentVersion = aws.Int64(currentVersion)
-			wait = true
-		}
-
-		// Prevent the following error on description-only updates:
-		//   InvalidParameterValue: Request cannot contain Cidr #.#.#.#/# in both AddPrefixListEntries and RemovePrefixListEntries
-		// Attempting to just delete the RemoveEntries item causes:
-		//   InvalidRequest: The request received was invalid.
-		// Therefore it seems we must issue two ModifyManagedPrefixList calls,
-		// one with a collection of all description-only removals and the
-		// second one will add them all back.
-		if len(input.AddEntries) > 0 && len(input.RemoveEntries) > 0 {
-			descriptionOnlyRemovals := []*ec2.RemovePrefixListEntry{}
-			removals := []*ec2.RemovePrefixListEntry{}
-
-			for _, removeEntry := range input.RemoveEntries {
-				inAddAndRemove := false
-
-				for _, addEntry := range input.AddEntries {
-					if aws.StringValue(addEntry.Cidr) == aws.StringValue(removeEntry.Cidr) {
-						inAddAndRemove = true
-						break
-					}
-				}
-
-				if inAddAndRemove {
-					descriptionOnlyRemovals = append(descriptionOnlyRemovals, removeEntry)
-				} else {
-					removals = append(removals, removeEntry)
-				}
-			}
-
-			if len(descriptionOnlyRemovals) > 0 {
-				_, err := conn.ModifyManagedPrefixListWithContext(ctx, &ec2.ModifyManagedPrefixListInput{
-					CurrentVersion: input.CurrentVersion,
-					PrefixListId:   aws.String(d.Id()),
-					RemoveEntries:  descriptionOnlyRemovals,
-				})
-
-				if err != nil {
-					return diag.Errorf("updating EC2 Managed Prefix List (%s): %s", d.Id(), err)
-				}
-
-				managedPrefixList, err := WaitManagedPrefixListModified(ctx, conn, d.Id())
-
-				if err != nil {
-					return diag.Errorf("waiting for EC2 Managed Prefix List (%s) update: %s", d.Id(), err)
-				}
-
-				input.CurrentVersion = managedPrefixList.Version
-			}
-
-			if len(removals) > 0 {
-				input.RemoveEntries = removals
-			} else {
-				// Prevent this error if RemoveEntries is list with no elements after removals:
-				//   InvalidRequest: The request received was invalid.
-				input.RemoveEntries = nil
-			}
+			PrefixListId:   aws.String(d.Id()),
+			PrefixListName: aws.String(d.Get("name").(string)),
 		}
 
 		_, err := conn.ModifyManagedPrefixListWithContext(ctx, input)
 
 		if err != nil {
-			return diag.Errorf("updating EC2 Managed Prefix List (%s): %s", d.Id(), err)
+			return diag.Errorf("updating EC2 Managed Prefix List (%s) name: %s", d.Id(), err)
 		}
+	}
 
-		if wait {
-			if _, err := WaitManagedPrefixListModified(ctx, conn, d.Id()); err != nil {
-				return diag.Errorf("waiting for EC2 Managed Prefix List (%s) update: %s", d.Id(), err)
-			}
+	if d.HasChange("entry") {
+		o, n := d.GetChange("entry")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		addEntries := expandAddPrefixListEntries(ns.Difference(os).List())
+		removeEntries := expandRemovePrefixListEntries(os.Difference(ns).List())
+
+		if err := modifyManagedPrefixListEntries(ctx, conn, d.Id(), currentVersion, addEntries, removeEntries, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("updating EC2 Managed Prefix List (%s): %s", d.Id(), err)
 		}
 	}
 
 	// Only decrease MaxEntries after entry(s) have had opportunity to be removed
 	if maxEntryChangedDecrease {
-		err := updateMaxEntry(ctx, conn, d.Id(), newMaxEntryInt)
+		_, err := updateMaxEntry(ctx, conn, d.Id(), newMaxEntryInt)
 		if err != nil {
 			return diag.Errorf("updating EC2 Managed Prefix List (%s) decreased MaxEntries : %s", d.Id(), err)
 		}
@@ -323,25 +278,118 @@ func resourceManagedPrefixListDelete(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
-func updateMaxEntry(ctx context.Context, conn *ec2.EC2, id string, maxEntries int64) error {
+// updateMaxEntry updates the prefix list's MaxEntries and returns the resulting prefix list version.
+func updateMaxEntry(ctx context.Context, conn *ec2.EC2, id string, maxEntries int64) (*int64, error) {
 	_, err := conn.ModifyManagedPrefixListWithContext(ctx, &ec2.ModifyManagedPrefixListInput{
 		PrefixListId: aws.String(id),
 		MaxEntries:   aws.Int64(maxEntries),
 	})
 
 	if err != nil {
-		return fmt.Errorf("updating MaxEntries for EC2 Managed Prefix List (%s): %s", id, err)
+		return nil, fmt.Errorf("updating MaxEntries for EC2 Managed Prefix List (%s): %s", id, err)
 	}
 
-	_, err = WaitManagedPrefixListModified(ctx, conn, id)
+	pl, err := WaitManagedPrefixListModified(ctx, conn, id)
 
 	if err != nil {
-		return fmt.Errorf("waiting for EC2 Managed Prefix List (%s) MaxEntries update: %s", id, err)
+		return nil, fmt.Errorf("waiting for EC2 Managed Prefix List (%s) MaxEntries update: %s", id, err)
+	}
+
+	return pl.Version, nil
+}
+
+// modifyManagedPrefixListEntries adds and removes prefix list entries in as few ModifyManagedPrefixList calls as the API allows.
+// If currentVersion is set, the first request is made against that version and each later request against the version
+// produced by the previous one, so that a concurrent out-of-band change fails the update with a version mismatch.
+// If currentVersion is nil, each request is made against the prefix list's live version.
+// The requests are not atomic: if a later request fails, the entries changed by earlier requests remain changed.
+func modifyManagedPrefixListEntries(ctx context.Context, conn *ec2.EC2, id string, currentVersion *int64, addEntries []*ec2.AddPrefixListEntry, removeEntries []*ec2.RemovePrefixListEntry, timeout time.Duration) error {
+	retryCodes := []string{errCodeIncorrectState}
+	if currentVersion == nil {
+		retryCodes = append(retryCodes, errCodePrefixListVersionMismatch)
+	}
+
+	batches := managedPrefixListEntryBatches(addEntries, removeEntries)
+
+	for i, input := range batches {
+		input.PrefixListId = aws.String(id)
+
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+			mutexKey := fmt.Sprintf("vpc-managed-prefix-list-%s", id)
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			if currentVersion != nil {
+				input.CurrentVersion = currentVersion
+			} else {
+				pl, err := FindManagedPrefixListByID(ctx, conn, id)
+
+				if err != nil {
+					return nil, fmt.Errorf("reading EC2 Managed Prefix List (%s): %w", id, err)
+				}
+
+				input.CurrentVersion = pl.Version
+			}
+
+			return conn.ModifyManagedPrefixListWithContext(ctx, input)
+		}, retryCodes...)
+
+		if err != nil {
+			return fmt.Errorf("modifying entries (request %d of %d): %w", i+1, len(batches), err)
+		}
+
+		pl, err := WaitManagedPrefixListModified(ctx, conn, id)
+
+		if err != nil {
+			return fmt.Errorf("waiting for modify (request %d of %d): %w", i+1, len(batches), err)
+		}
+
+		if currentVersion != nil {
+			currentVersion = pl.Version
+		}
 	}
 
 	return nil
 }
 
+// managedPrefixListEntryBatches splits entry additions and removals into ModifyManagedPrefixList requests.
+// Removals are sent before additions so that a prefix list near its MaxEntries has room for the new entries.
+// A CIDR can't be both removed and added in the same request (description-only changes), so such an addition starts a new request.
+func managedPrefixListEntryBatches(addEntries []*ec2.AddPrefixListEntry, removeEntries []*ec2.RemovePrefixListEntry) []*ec2.ModifyManagedPrefixListInput {
+	var batches []*ec2.ModifyManagedPrefixListInput
+	input, n, removed := &ec2.ModifyManagedPrefixListInput{}, 0, make(map[string]struct{})
+
+	flush := func() {
+		if n > 0 {
+			batches = append(batches, input)
+		}
+		input, n, removed = &ec2.ModifyManagedPrefixListInput{}, 0, make(map[string]struct{})
+	}
+
+	for _, v := range removeEntries {
+		if n == managedPrefixListModifyEntriesMax {
+			flush()
+		}
+
+		input.RemoveEntries = append(input.RemoveEntries, v)
+		removed[aws.StringValue(v.Cidr)] = struct{}{}
+		n++
+	}
+
+	for _, v := range addEntries {
+		if _, ok := removed[aws.StringValue(v.Cidr)]; ok || n == managedPrefixListModifyEntriesMax {
+			flush()
+		}
+
+		input.AddEntries = append(input.AddEntries, v)
+		n++
+	}
+
+	flush()
+
+	return batches
+}
+
 func expandAddPrefixListEntry(tfMap map[string]interface{}) *ec2.AddPrefixListEntry {
 	if tfMap == nil {
 		return nil
@@ -65,36 +65,12 @@ func resourceManagedPrefixListEntryCreate(ctx context.Context, d *schema.Resourc
 		addPrefixListEntry.Description = aws.String(v.(string))
 	}
 
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
-		mutexKey := fmt.Sprintf("vpc-managed-prefix-list-%s", plID)
-		conns.GlobalMutexKV.Lock(mutexKey)
-		defer conns.GlobalMutexKV.Unlock(mutexKey)
-
-		pl, err := FindManagedPrefixListByID(ctx, conn, plID)
-
-		if err != nil {
-			return nil, fmt.Errorf("reading VPC Managed Prefix List (%s): %w", plID, err)
-		}
-
-		input := &ec2.ModifyManagedPrefixListInput{
-			AddEntries:     []*ec2.AddPrefixListEntry{addPrefixListEntry},
-			CurrentVersion: pl.Version,
-			PrefixListId:   aws.String(plID),
-		}
-
-		return conn.ModifyManagedPrefixListWithContext(ctx, input)
-	}, errCodeIncorrectState, errCodePrefixListVersionMismatch)
-
-	if err != nil {
+	if err := modifyManagedPrefixListEntries(ctx, conn, plID, nil, []*ec2.AddPrefixListEntry{addPrefixListEntry}, nil, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("creating VPC Managed Prefix List Entry (%s): %s", id, err)
 	}
 
 	d.SetId(id)
 
-	if _, err := WaitManagedPrefixListModified(ctx, conn, plID); err != nil {
-		return diag.Errorf("waiting for VPC Managed Prefix List Entry (%s) create: %s", d.Id(), err)
-	}
-
 	return resourceManagedPrefixListEntryRead(ctx, d, meta)
 }
 
@@ -138,36 +114,12 @@ func resourceManagedPrefixListEntryDelete(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
-		mutexKey := fmt.Sprintf("vpc-managed-prefix-list-%s", plID)
-		conns.GlobalMutexKV.Lock(mutexKey)
-		defer conns.GlobalMutexKV.Unlock(mutexKey)
-
-		pl, err := FindManagedPrefixListByID(ctx, conn, plID)
+	removePrefixListEntry := &ec2.RemovePrefixListEntry{Cidr: aws.String(cidr)}
 
-		if err != nil {
-			return nil, fmt.Errorf("reading VPC Managed Prefix List (%s): %w", plID, err)
-		}
-
-		input := &ec2.ModifyManagedPrefixListInput{
-			CurrentVersion: pl.Version,
-			PrefixListId:   aws.String(plID),
-			RemoveEntries:  []*ec2.RemovePrefixListEntry{{Cidr: aws.String(cidr)}},
-		}
-
-		return conn.ModifyManagedPrefixListWithContext(ctx, input)
-	}, errCodeIncorrectState, errCodePrefixListVersionMismatch)
-
-	if err != nil {
+	if err := modifyManagedPrefixListEntries(ctx, conn, plID, nil, nil, []*ec2.RemovePrefixListEntry{removePrefixListEntry}, d.Timeout(schema.TimeoutDelete)); err != nil {
 		return diag.Errorf("deleting VPC Managed Prefix List Entry (%s): %s", d.Id(), err)
 	}
 
-	_, err = WaitManagedPrefixListModified(ctx, conn, plID)
-
-	if err != nil {
-		return diag.Errorf("waiting for VPC Managed Prefix List Entry (%s) delete: %s", d.Id(), err)
-	}
-
 	return nil
 }
 
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestManagedPrefixListEntryBatches(t *testing.T) {
+	t.Parallel()
+
+	addEntries := func(from, to int) []*ec2.AddPrefixListEntry {
+		var apiObjects []*ec2.AddPrefixListEntry
+		for i := from; i < to; i++ {
+			apiObjects = append(apiObjects, &ec2.AddPrefixListEntry{Cidr: aws.String(fmt.Sprintf("10.%d.%d.0/24", i/256, i%256))})
+		}
+		return apiObjects
+	}
+	removeEntries := func(from, to int) []*ec2.RemovePrefixListEntry {
+		var apiObjects []*ec2.RemovePrefixListEntry
+		for i := from; i < to; i++ {
+			apiObjects = append(apiObjects, &ec2.RemovePrefixListEntry{Cidr: aws.String(fmt.Sprintf("10.%d.%d.0/24", i/256, i%256))})
+		}
+		return apiObjects
+	}
+
+	type batch struct {
+		add, remove int
+	}
+
+	testCases := []struct {
+		Name     string
+		Add      []*ec2.AddPrefixListEntry
+		Remove   []*ec2.RemovePrefixListEntry
+		Expected []batch
+	}{
+		{
+			Name: "empty",
+		},
+		{
+			Name:     "single request",
+			Add:      addEntries(0, 60),
+			Remove:   removeEntries(100, 140),
+			Expected: []batch{{add: 60, remove: 40}},
+		},
+		{
+			Name:     "chunked additions",
+			Add:      addEntries(0, 250),
+			Expected: []batch{{add: 100}, {add: 100}, {add: 50}},
+		},
+		{
+			Name:     "removals before additions",
+			Add:      addEntries(0, 30),
+			Remove:   removeEntries(300, 420),
+			Expected: []batch{{remove: 100}, {add: 30, remove: 20}},
+		},
+		{
+			Name:     "description-only change",
+			Add:      addEntries(0, 3),
+			Remove:   removeEntries(1, 5),
+			Expected: []batch{{add: 1, remove: 4}, {add: 2}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			batches := managedPrefixListEntryBatches(testCase.Add, testCase.Remove)
+
+			if got, expected := len(batches), len(testCase.Expected); got != expected {
+				t.Fatalf("got %d batches, expected %d", got, expected)
+			}
+
+			for i, v := range batches {
+				if got, expected := len(v.AddEntries), testCase.Expected[i].add; got != expected {
+					t.Errorf("batch %d: got %d additions, expected %d", i, got, expected)
+				}
+				if got, expected := len(v.RemoveEntries), testCase.Expected[i].remove; got != expected {
+					t.Errorf("batch %d: got %d removals, expected %d", i, got, expected)
+				}
+
+				removed := make(map[string]struct{})
+				for _, v := range v.RemoveEntries {
+					removed[aws.StringValue(v.Cidr)] = struct{}{}
+				}
+				for _, v := range v.AddEntries {
+					if _, ok := removed[aws.StringValue(v.Cidr)]; ok {
+						t.Errorf("batch %d: %s both added and removed", i, aws.StringValue(v.Cidr))
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,761 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	reachabilityComponentTypeNetworkACL    = "network-acl"
+	reachabilityComponentTypeRouteTable    = "route-table"
+	reachabilityComponentTypeSecurityGroup = "security-group"
+
+	reachabilityDecisionAllow = "allow"
+	reachabilityDecisionDeny  = "deny"
+
+	reachabilityDirectionForward = "forward"
+	reachabilityDirectionReturn  = "return"
+
+	// Ephemeral port range assumed for return traffic when no source port is configured.
+	reachabilityEphemeralPortFrom = 1024
+	reachabilityEphemeralPortTo   = 65535
+)
+
+// @SDKDataSource("aws_vpc_reachability_check")
+func DataSourceReachabilityCheck() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceReachabilityCheckRead,
+
+		Schema: map[string]*schema.Schema{
+			"blocking_component_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"blocking_component_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_cidr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsCIDR,
+				ExactlyOneOf: []string{"destination_cidr", "destination_network_interface_id"},
+			},
+			"destination_network_interface_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"destination_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"hops": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"component_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"direction": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"explanation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "icmp", "icmpv6", "6", "17", "1", "58"}, true),
+			},
+			"reachable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"source_cidr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsCIDR,
+				ExactlyOneOf: []string{"source_cidr", "source_network_interface_id"},
+			},
+			"source_network_interface_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+		},
+	}
+}
+
+func dataSourceReachabilityCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	protocol := ProtocolForValue(d.Get("protocol").(string))
+	input := &reachabilityInput{
+		prefixLists: make(map[string][]netip.Prefix),
+		protocol:    protocol,
+	}
+
+	if protocol == "tcp" || protocol == "udp" {
+		v, ok := d.GetOk("destination_port")
+
+		if !ok {
+			return sdkdiag.AppendErrorf(diags, "destination_port must be set for protocol %s", protocol)
+		}
+
+		input.destinationPorts = reachabilityPortRange{from: int64(v.(int)), to: int64(v.(int))}
+		input.returnPorts = reachabilityPortRange{from: reachabilityEphemeralPortFrom, to: reachabilityEphemeralPortTo}
+
+		if v, ok := d.GetOk("source_port"); ok {
+			input.returnPorts = reachabilityPortRange{from: int64(v.(int)), to: int64(v.(int))}
+		}
+	}
+
+	sourceCIDR, destinationCIDR := d.Get("source_cidr").(string), d.Get("destination_cidr").(string)
+	sourceENIID, destinationENIID := d.Get("source_network_interface_id").(string), d.Get("destination_network_interface_id").(string)
+
+	if sourceENIID == "" && destinationENIID == "" {
+		return sdkdiag.AppendErrorf(diags, "at least one of source_network_interface_id or destination_network_interface_id must be set")
+	}
+
+	// Pick the address family of the CIDR endpoint, if any, for network interface endpoints.
+	ipv6 := false
+	for _, v := range []string{sourceCIDR, destinationCIDR} {
+		if v == "" {
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(v)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		ipv6 = prefix.Addr().Is6()
+	}
+
+	for _, v := range []struct {
+		cidr        string
+		eniID       string
+		endpoint    *reachabilityEndpoint
+		description string
+	}{
+		{sourceCIDR, sourceENIID, &input.source, "source"},
+		{destinationCIDR, destinationENIID, &input.destination, "destination"},
+	} {
+		if v.cidr != "" {
+			prefix, err := netip.ParsePrefix(v.cidr)
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			v.endpoint.prefix = prefix.Masked()
+
+			continue
+		}
+
+		if err := findReachabilityEndpoint(ctx, conn, v.eniID, ipv6, v.endpoint); err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading VPC reachability %s (%s): %s", v.description, v.eniID, err)
+		}
+	}
+
+	if err := findReachabilityPrefixLists(ctx, conn, input); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC reachability prefix lists: %s", err)
+	}
+
+	hops := evaluateReachability(input)
+
+	d.SetId(strings.Join([]string{
+		input.source.id(),
+		input.destination.id(),
+		protocol,
+		fmt.Sprintf("%d", input.destinationPorts.from),
+	}, "_"))
+
+	reachable := true
+	d.Set("blocking_component_id", nil)
+	d.Set("blocking_component_type", nil)
+	for _, hop := range hops {
+		if hop.decision == reachabilityDecisionDeny {
+			reachable = false
+			d.Set("blocking_component_id", hop.componentID)
+			d.Set("blocking_component_type", hop.componentType)
+
+			break
+		}
+	}
+
+	if err := d.Set("hops", flattenReachabilityHops(hops)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting hops: %s", err)
+	}
+	d.Set("reachable", reachable)
+
+	return diags
+}
+
+func findReachabilityEndpoint(ctx context.Context, conn *ec2.EC2, eniID string, ipv6 bool, endpoint *reachabilityEndpoint) error {
+	eni, err := FindNetworkInterfaceByID(ctx, conn, eniID)
+
+	if err != nil {
+		return err
+	}
+
+	var address string
+	if ipv6 {
+		if len(eni.Ipv6Addresses) > 0 {
+			address = aws.StringValue(eni.Ipv6Addresses[0].Ipv6Address)
+		}
+	} else {
+		address = aws.StringValue(eni.PrivateIpAddress)
+	}
+
+	addr, err := netip.ParseAddr(address)
+
+	if err != nil {
+		return fmt.Errorf("network interface has no usable IP address: %w", err)
+	}
+
+	endpoint.networkInterfaceID = eniID
+	endpoint.prefix = netip.PrefixFrom(addr, addr.BitLen())
+	endpoint.subnetID = aws.StringValue(eni.SubnetId)
+	endpoint.vpcID = aws.StringValue(eni.VpcId)
+	endpoint.securityGroupIDs = make(map[string]struct{})
+
+	var groupIDs []string
+	for _, v := range eni.Groups {
+		groupID := aws.StringValue(v.GroupId)
+		groupIDs = append(groupIDs, groupID)
+		endpoint.securityGroupIDs[groupID] = struct{}{}
+	}
+
+	if len(groupIDs) > 0 {
+		endpoint.securityGroups, err = FindSecurityGroups(ctx, conn, &ec2.DescribeSecurityGroupsInput{
+			GroupIds: aws.StringSlice(groupIDs),
+		})
+
+		if err != nil {
+			return fmt.Errorf("reading security groups: %w", err)
+		}
+	}
+
+	endpoint.networkACL, err = FindNetworkACL(ctx, conn, &ec2.DescribeNetworkAclsInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"association.subnet-id": endpoint.subnetID,
+		}),
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading network ACL: %w", err)
+	}
+
+	endpoint.routeTable, err = FindRouteTable(ctx, conn, &ec2.DescribeRouteTablesInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"association.subnet-id": endpoint.subnetID,
+		}),
+	})
+
+	// Subnets without an explicit association use the VPC's main route table.
+	if tfresource.NotFound(err) {
+		endpoint.routeTable, err = FindMainRouteTableByVPCID(ctx, conn, endpoint.vpcID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading route table: %w", err)
+	}
+
+	return nil
+}
+
+func findReachabilityPrefixLists(ctx context.Context, conn *ec2.EC2, input *reachabilityInput) error {
+	var ids []string
+
+	for _, endpoint := range []*reachabilityEndpoint{&input.source, &input.destination} {
+		for _, sg := range endpoint.securityGroups {
+			for _, perms := range [][]*ec2.IpPermission{sg.IpPermissions, sg.IpPermissionsEgress} {
+				for _, perm := range perms {
+					for _, v := range perm.PrefixListIds {
+						ids = append(ids, aws.StringValue(v.PrefixListId))
+					}
+				}
+			}
+		}
+
+		if endpoint.routeTable != nil {
+			for _, route := range endpoint.routeTable.Routes {
+				if v := aws.StringValue(route.DestinationPrefixListId); v != "" {
+					ids = append(ids, v)
+				}
+			}
+		}
+	}
+
+	for _, id := range ids {
+		if _, ok := input.prefixLists[id]; ok {
+			continue
+		}
+
+		entries, err := FindManagedPrefixListEntriesByID(ctx, conn, id)
+
+		if err != nil {
+			return fmt.Errorf("reading EC2 Managed Prefix List (%s) entries: %w", id, err)
+		}
+
+		var prefixes []netip.Prefix
+		for _, entry := range entries {
+			if prefix, err := netip.ParsePrefix(aws.StringValue(entry.Cidr)); err == nil {
+				prefixes = append(prefixes, prefix)
+			}
+		}
+
+		input.prefixLists[id] = prefixes
+	}
+
+	return nil
+}
+
+// reachabilityEndpoint is one end of the path: a network interface, or a CIDR outside the evaluated VPCs.
+type reachabilityEndpoint struct {
+	networkACL         *ec2.NetworkAcl
+	networkInterfaceID string
+	prefix             netip.Prefix
+	routeTable         *ec2.RouteTable
+	securityGroupIDs   map[string]struct{}
+	securityGroups     []*ec2.SecurityGroup
+	subnetID           string
+	vpcID              string
+}
+
+func (e *reachabilityEndpoint) isNetworkInterface() bool {
+	return e.networkInterfaceID != ""
+}
+
+func (e *reachabilityEndpoint) id() string {
+	if e.isNetworkInterface() {
+		return e.networkInterfaceID
+	}
+
+	return e.prefix.String()
+}
+
+type reachabilityPortRange struct {
+	from, to int64
+}
+
+type reachabilityInput struct {
+	destination      reachabilityEndpoint
+	destinationPorts reachabilityPortRange
+	prefixLists      map[string][]netip.Prefix
+	protocol         string
+	returnPorts      reachabilityPortRange
+	source           reachabilityEndpoint
+}
+
+type reachabilityHop struct {
+	componentID   string
+	componentType string
+	decision      string
+	direction     string
+	explanation   string
+}
+
+// evaluateReachability walks the path from source to destination the way a packet would,
+// stopping at the first component that denies it.
+// Security groups are stateful, so return traffic is only evaluated against network ACLs.
+func evaluateReachability(input *reachabilityInput) []reachabilityHop {
+	var hops []reachabilityHop
+	source, destination := &input.source, &input.destination
+
+	// Traffic within a subnet does not traverse the subnet's network ACL.
+	crossSubnet := !source.isNetworkInterface() || !destination.isNetworkInterface() || source.subnetID != destination.subnetID
+
+	steps := []func() *reachabilityHop{
+		func() *reachabilityHop {
+			if !source.isNetworkInterface() {
+				return nil
+			}
+			return evaluateReachabilitySecurityGroups(input, source, destination, true)
+		},
+		func() *reachabilityHop {
+			if !source.isNetworkInterface() || !crossSubnet {
+				return nil
+			}
+			return evaluateReachabilityNetworkACL(source.networkACL, true, destination.prefix, input.protocol, input.destinationPorts, reachabilityDirectionForward)
+		},
+		func() *reachabilityHop {
+			if !source.isNetworkInterface() || !crossSubnet {
+				return nil
+			}
+			return evaluateReachabilityRouteTable(input, source.routeTable, destination)
+		},
+		func() *reachabilityHop {
+			if !destination.isNetworkInterface() || !crossSubnet {
+				return nil
+			}
+			return evaluateReachabilityNetworkACL(destination.networkACL, false, source.prefix, input.protocol, input.destinationPorts, reachabilityDirectionForward)
+		},
+		func() *reachabilityHop {
+			if !destination.isNetworkInterface() {
+				return nil
+			}
+			return evaluateReachabilitySecurityGroups(input, destination, source, false)
+		},
+		func() *reachabilityHop {
+			if !destination.isNetworkInterface() || !crossSubnet {
+				return nil
+			}
+			return evaluateReachabilityNetworkACL(destination.networkACL, true, source.prefix, input.protocol, input.returnPorts, reachabilityDirectionReturn)
+		},
+		func() *reachabilityHop {
+			if !source.isNetworkInterface() || !crossSubnet {
+				return nil
+			}
+			return evaluateReachabilityNetworkACL(source.networkACL, false, destination.prefix, input.protocol, input.returnPorts, reachabilityDirectionReturn)
+		},
+	}
+
+	for _, step := range steps {
+		hop := step()
+
+		if hop == nil {
+			continue
+		}
+
+		hops = append(hops, *hop)
+
+		if hop.decision == reachabilityDecisionDeny {
+			break
+		}
+	}
+
+	return hops
+}
+
+// evaluateReachabilitySecurityGroups evaluates the egress (or ingress) rules of the endpoint's security groups for traffic to (or from) the peer.
+func evaluateReachabilitySecurityGroups(input *reachabilityInput, endpoint, peer *reachabilityEndpoint, egress bool) *reachabilityHop {
+	direction, preposition := "egress", "to"
+	if !egress {
+		direction, preposition = "ingress", "from"
+	}
+
+	var groupIDs []string
+	for _, sg := range endpoint.securityGroups {
+		groupID := aws.StringValue(sg.GroupId)
+		groupIDs = append(groupIDs, groupID)
+
+		perms := sg.IpPermissions
+		if egress {
+			perms = sg.IpPermissionsEgress
+		}
+
+		for _, perm := range perms {
+			if !reachabilityProtocolMatches(aws.StringValue(perm.IpProtocol), input.protocol) {
+				continue
+			}
+
+			if reachabilityPortsApply(input.protocol) && ProtocolForValue(aws.StringValue(perm.IpProtocol)) != "-1" {
+				if !(aws.Int64Value(perm.FromPort) <= input.destinationPorts.from && input.destinationPorts.to <= aws.Int64Value(perm.ToPort)) {
+					continue
+				}
+			}
+
+			if peerDescription, ok := reachabilityIPPermissionMatchesPeer(input, perm, peer); ok {
+				return &reachabilityHop{
+					componentID:   groupID,
+					componentType: reachabilityComponentTypeSecurityGroup,
+					decision:      reachabilityDecisionAllow,
+					direction:     reachabilityDirectionForward,
+					explanation:   fmt.Sprintf("%s rule allows %s %s %s", direction, reachabilityTrafficDescription(input.protocol, input.destinationPorts), preposition, peerDescription),
+				}
+			}
+		}
+	}
+
+	sort.Strings(groupIDs)
+
+	return &reachabilityHop{
+		componentID:   strings.Join(groupIDs, ","),
+		componentType: reachabilityComponentTypeSecurityGroup,
+		decision:      reachabilityDecisionDeny,
+		direction:     reachabilityDirectionForward,
+		explanation:   fmt.Sprintf("no %s rule allows %s %s %s", direction, reachabilityTrafficDescription(input.protocol, input.destinationPorts), preposition, peer.id()),
+	}
+}
+
+func reachabilityIPPermissionMatchesPeer(input *reachabilityInput, perm *ec2.IpPermission, peer *reachabilityEndpoint) (string, bool) {
+	for _, v := range perm.IpRanges {
+		if prefix, err := netip.ParsePrefix(aws.StringValue(v.CidrIp)); err == nil && reachabilityPrefixContains(prefix, peer.prefix) {
+			return prefix.String(), true
+		}
+	}
+
+	for _, v := range perm.Ipv6Ranges {
+		if prefix, err := netip.ParsePrefix(aws.StringValue(v.CidrIpv6)); err == nil && reachabilityPrefixContains(prefix, peer.prefix) {
+			return prefix.String(), true
+		}
+	}
+
+	for _, v := range perm.PrefixListIds {
+		prefixListID := aws.StringValue(v.PrefixListId)
+
+		for _, prefix := range input.prefixLists[prefixListID] {
+			if reachabilityPrefixContains(prefix, peer.prefix) {
+				return fmt.Sprintf("%s (%s)", prefixListID, prefix), true
+			}
+		}
+	}
+
+	if peer.isNetworkInterface() {
+		for _, v := range perm.UserIdGroupPairs {
+			groupID := aws.StringValue(v.GroupId)
+
+			if _, ok := peer.securityGroupIDs[groupID]; ok {
+				return groupID, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// evaluateReachabilityNetworkACL evaluates network ACL entries in rule number order. The first entry that
+// covers the whole peer prefix and port range decides; a deny entry that only partially overlaps also denies.
+func evaluateReachabilityNetworkACL(nacl *ec2.NetworkAcl, egress bool, peer netip.Prefix, protocol string, ports reachabilityPortRange, direction string) *reachabilityHop {
+	naclID := aws.StringValue(nacl.NetworkAclId)
+	entryDirection := "inbound"
+	if egress {
+		entryDirection = "outbound"
+	}
+
+	var entries []*ec2.NetworkAclEntry
+	for _, entry := range nacl.Entries {
+		if aws.BoolValue(entry.Egress) == egress {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return aws.Int64Value(entries[i].RuleNumber) < aws.Int64Value(entries[j].RuleNumber)
+	})
+
+	for _, entry := range entries {
+		if !reachabilityProtocolMatches(aws.StringValue(entry.Protocol), protocol) {
+			continue
+		}
+
+		cidr := aws.StringValue(entry.CidrBlock)
+		if v := aws.StringValue(entry.Ipv6CidrBlock); v != "" {
+			cidr = v
+		}
+
+		prefix, err := netip.ParsePrefix(cidr)
+
+		if err != nil {
+			continue
+		}
+
+		covers, overlaps := reachabilityPrefixContains(prefix, peer), prefix.Overlaps(peer)
+
+		if reachabilityPortsApply(protocol) && ProtocolForValue(aws.StringValue(entry.Protocol)) != "-1" && entry.PortRange != nil {
+			from, to := aws.Int64Value(entry.PortRange.From), aws.Int64Value(entry.PortRange.To)
+			covers = covers && from <= ports.from && ports.to <= to
+			overlaps = overlaps && from <= ports.to && ports.from <= to
+		}
+
+		ruleNumber := aws.Int64Value(entry.RuleNumber)
+
+		switch action := aws.StringValue(entry.RuleAction); {
+		case action == ec2.RuleActionAllow && covers:
+			return &reachabilityHop{
+				componentID:   naclID,
+				componentType: reachabilityComponentTypeNetworkACL,
+				decision:      reachabilityDecisionAllow,
+				direction:     direction,
+				explanation:   fmt.Sprintf("%s rule %d allows %s for %s", entryDirection, ruleNumber, reachabilityTrafficDescription(protocol, ports), peer),
+			}
+		case action == ec2.RuleActionDeny && overlaps:
+			return &reachabilityHop{
+				componentID:   naclID,
+				componentType: reachabilityComponentTypeNetworkACL,
+				decision:      reachabilityDecisionDeny,
+				direction:     direction,
+				explanation:   fmt.Sprintf("%s rule %d denies %s for %s", entryDirection, ruleNumber, reachabilityTrafficDescription(protocol, ports), peer),
+			}
+		}
+	}
+
+	return &reachabilityHop{
+		componentID:   naclID,
+		componentType: reachabilityComponentTypeNetworkACL,
+		decision:      reachabilityDecisionDeny,
+		direction:     direction,
+		explanation:   fmt.Sprintf("no %s rule allows %s for %s", entryDirection, reachabilityTrafficDescription(protocol, ports), peer),
+	}
+}
+
+// evaluateReachabilityRouteTable selects the most specific active route to the destination.
+func evaluateReachabilityRouteTable(input *reachabilityInput, routeTable *ec2.RouteTable, destination *reachabilityEndpoint) *reachabilityHop {
+	routeTableID := aws.StringValue(routeTable.RouteTableId)
+
+	var match *ec2.Route
+	var matchPrefix netip.Prefix
+	for _, route := range routeTable.Routes {
+		var candidates []netip.Prefix
+
+		for _, v := range []string{aws.StringValue(route.DestinationCidrBlock), aws.StringValue(route.DestinationIpv6CidrBlock)} {
+			if prefix, err := netip.ParsePrefix(v); err == nil {
+				candidates = append(candidates, prefix)
+			}
+		}
+
+		if v := aws.StringValue(route.DestinationPrefixListId); v != "" {
+			candidates = append(candidates, input.prefixLists[v]...)
+		}
+
+		for _, prefix := range candidates {
+			if reachabilityPrefixContains(prefix, destination.prefix) && (match == nil || prefix.Bits() > matchPrefix.Bits()) {
+				match, matchPrefix = route, prefix
+			}
+		}
+	}
+
+	if match == nil {
+		return &reachabilityHop{
+			componentID:   routeTableID,
+			componentType: reachabilityComponentTypeRouteTable,
+			decision:      reachabilityDecisionDeny,
+			direction:     reachabilityDirectionForward,
+			explanation:   fmt.Sprintf("no route to %s", destination.prefix),
+		}
+	}
+
+	target := reachabilityRouteTarget(match)
+
+	if aws.StringValue(match.State) == ec2.RouteStateBlackhole {
+		return &reachabilityHop{
+			componentID:   routeTableID,
+			componentType: reachabilityComponentTypeRouteTable,
+			decision:      reachabilityDecisionDeny,
+			direction:     reachabilityDirectionForward,
+			explanation:   fmt.Sprintf("route %s to %s is a blackhole", matchPrefix, target),
+		}
+	}
+
+	explanation := fmt.Sprintf("route %s to %s", matchPrefix, target)
+	if target == "local" && destination.isNetworkInterface() && destination.vpcID != input.source.vpcID {
+		return &reachabilityHop{
+			componentID:   routeTableID,
+			componentType: reachabilityComponentTypeRouteTable,
+			decision:      reachabilityDecisionDeny,
+			direction:     reachabilityDirectionForward,
+			explanation:   fmt.Sprintf("%s does not reach %s in %s", explanation, destination.id(), destination.vpcID),
+		}
+	}
+
+	return &reachabilityHop{
+		componentID:   routeTableID,
+		componentType: reachabilityComponentTypeRouteTable,
+		decision:      reachabilityDecisionAllow,
+		direction:     reachabilityDirectionForward,
+		explanation:   explanation,
+	}
+}
+
+func reachabilityRouteTarget(route *ec2.Route) string {
+	for _, v := range []*string{
+		route.GatewayId,
+		route.NatGatewayId,
+		route.TransitGatewayId,
+		route.VpcPeeringConnectionId,
+		route.NetworkInterfaceId,
+		route.InstanceId,
+		route.EgressOnlyInternetGatewayId,
+		route.LocalGatewayId,
+		route.CarrierGatewayId,
+		route.CoreNetworkArn,
+	} {
+		if v := aws.StringValue(v); v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+func reachabilityProtocolMatches(ruleProtocol, protocol string) bool {
+	ruleProtocol = ProtocolForValue(ruleProtocol)
+
+	return ruleProtocol == "-1" || ruleProtocol == protocol
+}
+
+// reachabilityPortsApply returns whether rules for the specified protocol have port ranges.
+// ICMP type and code are not evaluated.
+func reachabilityPortsApply(protocol string) bool {
+	return protocol == "tcp" || protocol == "udp"
+}
+
+func reachabilityPrefixContains(outer, inner netip.Prefix) bool {
+	return outer.Bits() <= inner.Bits() && outer.Contains(inner.Addr())
+}
+
+func reachabilityTrafficDescription(protocol string, ports reachabilityPortRange) string {
+	if !reachabilityPortsApply(protocol) {
+		return protocol
+	}
+
+	if ports.from == ports.to {
+		return fmt.Sprintf("%s/%d", protocol, ports.from)
+	}
+
+	return fmt.Sprintf("%s/%d-%d", protocol, ports.from, ports.to)
+}
+
+func flattenReachabilityHops(hops []reachabilityHop) []interface{} {
+	tfList := make([]interface{}, 0, len(hops))
+
+	for _, hop := range hops {
+		tfList = append(tfList, map[string]interface{}{
+			"component_id":   hop.componentID,
+			"component_type": hop.componentType,
+			"decision":       hop.decision,
+			"direction":      hop.direction,
+			"explanation":    hop.explanation,
+		})
+	}
+
+	return tfList
+}
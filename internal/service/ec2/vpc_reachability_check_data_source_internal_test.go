@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func testReachabilityNetworkACL(id string, entries ...*ec2.NetworkAclEntry) *ec2.NetworkAcl {
+	return &ec2.NetworkAcl{
+		Entries: append(entries,
+			&ec2.NetworkAclEntry{CidrBlock: aws.String("0.0.0.0/0"), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: aws.String(ec2.RuleActionDeny), RuleNumber: aws.Int64(32767)},
+			&ec2.NetworkAclEntry{CidrBlock: aws.String("0.0.0.0/0"), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: aws.String(ec2.RuleActionDeny), RuleNumber: aws.Int64(32767)},
+		),
+		NetworkAclId: aws.String(id),
+	}
+}
+
+func testReachabilityAllowAllNetworkACL(id string) *ec2.NetworkAcl {
+	return testReachabilityNetworkACL(id,
+		&ec2.NetworkAclEntry{CidrBlock: aws.String("0.0.0.0/0"), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: aws.String(ec2.RuleActionAllow), RuleNumber: aws.Int64(100)},
+		&ec2.NetworkAclEntry{CidrBlock: aws.String("0.0.0.0/0"), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: aws.String(ec2.RuleActionAllow), RuleNumber: aws.Int64(100)},
+	)
+}
+
+func testReachabilityRouteTable(routes ...*ec2.Route) *ec2.RouteTable {
+	return &ec2.RouteTable{
+		RouteTableId: aws.String("rtb-00000001"),
+		Routes: append(routes, &ec2.Route{
+			DestinationCidrBlock: aws.String("10.0.0.0/16"),
+			GatewayId:            aws.String("local"),
+			State:                aws.String(ec2.RouteStateActive),
+		}),
+	}
+}
+
+func testReachabilityInput() *reachabilityInput {
+	return &reachabilityInput{
+		destination: reachabilityEndpoint{
+			networkACL:         testReachabilityAllowAllNetworkACL("acl-00000002"),
+			networkInterfaceID: "eni-00000002",
+			prefix:             netip.MustParsePrefix("10.0.2.10/32"),
+			routeTable:         testReachabilityRouteTable(),
+			securityGroupIDs:   map[string]struct{}{"sg-00000002": {}},
+			securityGroups: []*ec2.SecurityGroup{{
+				GroupId: aws.String("sg-00000002"),
+				IpPermissions: []*ec2.IpPermission{{
+					FromPort:         aws.Int64(443),
+					IpProtocol:       aws.String("tcp"),
+					ToPort:           aws.Int64(443),
+					UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-00000001")}},
+				}},
+			}},
+			subnetID: "subnet-00000002",
+			vpcID:    "vpc-00000001",
+		},
+		destinationPorts: reachabilityPortRange{from: 443, to: 443},
+		prefixLists:      map[string][]netip.Prefix{},
+		protocol:         "tcp",
+		returnPorts:      reachabilityPortRange{from: reachabilityEphemeralPortFrom, to: reachabilityEphemeralPortTo},
+		source: reachabilityEndpoint{
+			networkACL:         testReachabilityAllowAllNetworkACL("acl-00000001"),
+			networkInterfaceID: "eni-00000001",
+			prefix:             netip.MustParsePrefix("10.0.1.10/32"),
+			routeTable:         testReachabilityRouteTable(),
+			securityGroupIDs:   map[string]struct{}{"sg-00000001": {}},
+			securityGroups: []*ec2.SecurityGroup{{
+				GroupId: aws.String("sg-00000001"),
+				IpPermissionsEgress: []*ec2.IpPermission{{
+					IpProtocol: aws.String("-1"),
+					IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+				}},
+			}},
+			subnetID: "subnet-00000001",
+			vpcID:    "vpc-00000001",
+		},
+	}
+}
+
+func TestEvaluateReachability(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                  string
+		Modify                func(*reachabilityInput)
+		ExpectedHops          int
+		ExpectedBlockingID    string
+		ExpectedBlockingType  string
+		ExpectedBlockingOnHop string
+	}{
+		{
+			Name:         "reachable",
+			Modify:       func(*reachabilityInput) {},
+			ExpectedHops: 7,
+		},
+		{
+			Name: "same subnet",
+			Modify: func(input *reachabilityInput) {
+				input.destination.subnetID = input.source.subnetID
+				input.source.networkACL = testReachabilityNetworkACL("acl-00000001")
+			},
+			ExpectedHops: 2,
+		},
+		{
+			Name: "security group ingress port",
+			Modify: func(input *reachabilityInput) {
+				input.destinationPorts = reachabilityPortRange{from: 80, to: 80}
+			},
+			ExpectedHops:          5,
+			ExpectedBlockingID:    "sg-00000002",
+			ExpectedBlockingType:  reachabilityComponentTypeSecurityGroup,
+			ExpectedBlockingOnHop: reachabilityDirectionForward,
+		},
+		{
+			Name: "security group egress",
+			Modify: func(input *reachabilityInput) {
+				input.source.securityGroups[0].IpPermissionsEgress = nil
+			},
+			ExpectedHops:          1,
+			ExpectedBlockingID:    "sg-00000001",
+			ExpectedBlockingType:  reachabilityComponentTypeSecurityGroup,
+			ExpectedBlockingOnHop: reachabilityDirectionForward,
+		},
+		{
+			Name: "network ACL deny before allow",
+			Modify: func(input *reachabilityInput) {
+				input.destination.networkACL = testReachabilityNetworkACL("acl-00000002",
+					&ec2.NetworkAclEntry{CidrBlock: aws.String("10.0.1.0/24"), Egress: aws.Bool(false), PortRange: &ec2.PortRange{From: aws.Int64(443), To: aws.Int64(443)}, Protocol: aws.String("6"), RuleAction: aws.String(ec2.RuleActionDeny), RuleNumber: aws.Int64(50)},
+					&ec2.NetworkAclEntry{CidrBlock: aws.String("0.0.0.0/0"), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: aws.String(ec2.RuleActionAllow), RuleNumber: aws.Int64(100)},
+				)
+			},
+			ExpectedHops:          4,
+			ExpectedBlockingID:    "acl-00000002",
+			ExpectedBlockingType:  reachabilityComponentTypeNetworkACL,
+			ExpectedBlockingOnHop: reachabilityDirectionForward,
+		},
+		{
+			Name: "network ACL return ephemeral ports",
+			Modify: func(input *reachabilityInput) {
+				input.destination.networkACL = testReachabilityNetworkACL("acl-00000002",
+					&ec2.NetworkAclEntry{CidrBlock: aws.String("0.0.0.0/0"), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: aws.String(ec2.RuleActionAllow), RuleNumber: aws.Int64(100)},
+					&ec2.NetworkAclEntry{CidrBlock: aws.String("0.0.0.0/0"), Egress: aws.Bool(true), PortRange: &ec2.PortRange{From: aws.Int64(443), To: aws.Int64(443)}, Protocol: aws.String("6"), RuleAction: aws.String(ec2.RuleActionAllow), RuleNumber: aws.Int64(100)},
+				)
+			},
+			ExpectedHops:          6,
+			ExpectedBlockingID:    "acl-00000002",
+			ExpectedBlockingType:  reachabilityComponentTypeNetworkACL,
+			ExpectedBlockingOnHop: reachabilityDirectionReturn,
+		},
+		{
+			Name: "blackhole route",
+			Modify: func(input *reachabilityInput) {
+				input.source.routeTable = testReachabilityRouteTable(&ec2.Route{
+					DestinationCidrBlock: aws.String("10.0.2.0/24"),
+					NetworkInterfaceId:   aws.String("eni-00000009"),
+					State:                aws.String(ec2.RouteStateBlackhole),
+				})
+			},
+			ExpectedHops:          3,
+			ExpectedBlockingID:    "rtb-00000001",
+			ExpectedBlockingType:  reachabilityComponentTypeRouteTable,
+			ExpectedBlockingOnHop: reachabilityDirectionForward,
+		},
+		{
+			Name: "no route",
+			Modify: func(input *reachabilityInput) {
+				input.destination = reachabilityEndpoint{prefix: netip.MustParsePrefix("192.168.0.0/24")}
+			},
+			ExpectedHops:          3,
+			ExpectedBlockingID:    "rtb-00000001",
+			ExpectedBlockingType:  reachabilityComponentTypeRouteTable,
+			ExpectedBlockingOnHop: reachabilityDirectionForward,
+		},
+		{
+			Name: "prefix list route",
+			Modify: func(input *reachabilityInput) {
+				input.destination = reachabilityEndpoint{prefix: netip.MustParsePrefix("192.168.0.0/24")}
+				input.prefixLists["pl-00000001"] = []netip.Prefix{netip.MustParsePrefix("192.168.0.0/16")}
+				input.source.routeTable = testReachabilityRouteTable(&ec2.Route{
+					DestinationPrefixListId: aws.String("pl-00000001"),
+					TransitGatewayId:        aws.String("tgw-00000001"),
+					State:                   aws.String(ec2.RouteStateActive),
+				})
+			},
+			ExpectedHops: 4,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			input := testReachabilityInput()
+			testCase.Modify(input)
+
+			hops := evaluateReachability(input)
+
+			if got, expected := len(hops), testCase.ExpectedHops; got != expected {
+				t.Fatalf("got %d hops, expected %d: %+v", got, expected, hops)
+			}
+
+			last := hops[len(hops)-1]
+
+			if testCase.ExpectedBlockingID == "" {
+				for _, hop := range hops {
+					if hop.decision != reachabilityDecisionAllow {
+						t.Errorf("unexpected hop %+v", hop)
+					}
+				}
+
+				return
+			}
+
+			if last.decision != reachabilityDecisionDeny {
+				t.Fatalf("got decision %s on last hop, expected %s", last.decision, reachabilityDecisionDeny)
+			}
+			if last.componentID != testCase.ExpectedBlockingID {
+				t.Errorf("got blocking component %s, expected %s", last.componentID, testCase.ExpectedBlockingID)
+			}
+			if last.componentType != testCase.ExpectedBlockingType {
+				t.Errorf("got blocking component type %s, expected %s", last.componentType, testCase.ExpectedBlockingType)
+			}
+			if last.direction != testCase.ExpectedBlockingOnHop {
+				t.Errorf("got blocking direction %s, expected %s", last.direction, testCase.ExpectedBlockingOnHop)
+			}
+		})
+	}
+}
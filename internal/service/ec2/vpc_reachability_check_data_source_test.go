@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCReachabilityCheckDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_reachability_check.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCReachabilityCheckDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "reachable", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "blocking_component_id", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "blocking_component_type", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.#", "7"),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.0.component_type", "security-group"),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.0.decision", "allow"),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.2.component_type", "route-table"),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.6.direction", "return"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCReachabilityCheckDataSource_blocked(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_reachability_check.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCReachabilityCheckDataSourceConfig_blocked(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "reachable", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "blocking_component_id", "aws_security_group.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "blocking_component_type", "security-group"),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.#", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.4.decision", "deny"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCReachabilityCheckDataSource_cidr(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_reachability_check.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCReachabilityCheckDataSourceConfig_cidr(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "reachable", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "blocking_component_type", "route-table"),
+					resource.TestCheckResourceAttr(dataSourceName, "hops.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCReachabilityCheckDataSourceConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_network_interface" "source" {
+  subnet_id = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccVPCReachabilityCheckDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCReachabilityCheckDataSourceConfig_base(rName), fmt.Sprintf(`
+resource "aws_network_interface" "destination" {
+  subnet_id = aws_subnet.test[1].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_vpc_reachability_check" "test" {
+  source_network_interface_id      = aws_network_interface.source.id
+  destination_network_interface_id = aws_network_interface.destination.id
+  protocol                         = "tcp"
+  destination_port                 = 443
+}
+`, rName))
+}
+
+func testAccVPCReachabilityCheckDataSourceConfig_blocked(rName string) string {
+	return acctest.ConfigCompose(testAccVPCReachabilityCheckDataSourceConfig_base(rName), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  ingress {
+    from_port   = 80
+    to_port     = 80
+    protocol    = "tcp"
+    cidr_blocks = [aws_vpc.test.cidr_block]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "destination" {
+  subnet_id       = aws_subnet.test[1].id
+  security_groups = [aws_security_group.test.id]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_vpc_reachability_check" "test" {
+  source_network_interface_id      = aws_network_interface.source.id
+  destination_network_interface_id = aws_network_interface.destination.id
+  protocol                         = "tcp"
+  destination_port                 = 443
+}
+`, rName))
+}
+
+func testAccVPCReachabilityCheckDataSourceConfig_cidr(rName string) string {
+	return acctest.ConfigCompose(testAccVPCReachabilityCheckDataSourceConfig_base(rName), `
+data "aws_vpc_reachability_check" "test" {
+  source_network_interface_id = aws_network_interface.source.id
+  destination_cidr            = "8.8.8.8/32"
+  protocol                    = "udp"
+  destination_port            = 53
+}
+`)
+}
@@ -0,0 +1,537 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_vpc_security_group_rules_exclusive")
+func ResourceSecurityGroupRulesExclusive() *schema.Resource {
+	ruleSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cidr_ipv4": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+			},
+			"cidr_ipv6": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validSecurityGroupRuleDescription,
+			},
+			"from_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(-1, 65535),
+			},
+			"ip_protocol": {
+				Type:      schema.TypeString,
+				Required:  true,
+				StateFunc: ProtocolStateFunc,
+			},
+			"prefix_list_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"referenced_security_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"to_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(-1, 65535),
+			},
+		},
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSecurityGroupRulesExclusivePut,
+		ReadWithoutTimeout:   resourceSecurityGroupRulesExclusiveRead,
+		UpdateWithoutTimeout: resourceSecurityGroupRulesExclusivePut,
+		DeleteWithoutTimeout: resourceSecurityGroupRulesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("security_group_id", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"egress": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     ruleSchema,
+				Set:      securityGroupRuleExclusiveHash,
+			},
+			"ingress": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     ruleSchema,
+				Set:      securityGroupRuleExclusiveHash,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSecurityGroupRulesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	groupID := d.Get("security_group_id").(string)
+
+	want, err := expandSecurityGroupRulesExclusive(d.Get("ingress").(*schema.Set).List(), d.Get("egress").(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "VPC Security Group (%s) exclusive rules: %s", groupID, err)
+	}
+
+	have, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Security Group (%s) rules: %s", groupID, err)
+	}
+
+	changes := diffSecurityGroupRulesExclusive(have, want, meta.(*conns.AWSClient).AccountID)
+
+	// Authorize new rules before revoking stale ones so that traffic matched by
+	// both the old and the new rule set is never interrupted.
+	if v := changes.authorizeIngress; len(v) > 0 {
+		input := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       aws.String(groupID),
+			IpPermissions: v,
+		}
+
+		if _, err := conn.AuthorizeSecurityGroupIngressWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "authorizing VPC Security Group (%s) ingress rules: %s", groupID, err)
+		}
+	}
+
+	if v := changes.authorizeEgress; len(v) > 0 {
+		input := &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       aws.String(groupID),
+			IpPermissions: v,
+		}
+
+		if _, err := conn.AuthorizeSecurityGroupEgressWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "authorizing VPC Security Group (%s) egress rules: %s", groupID, err)
+		}
+	}
+
+	if v := changes.modify; len(v) > 0 {
+		input := &ec2.ModifySecurityGroupRulesInput{
+			GroupId:            aws.String(groupID),
+			SecurityGroupRules: v,
+		}
+
+		if _, err := conn.ModifySecurityGroupRulesWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying VPC Security Group (%s) rules: %s", groupID, err)
+		}
+	}
+
+	if v := changes.revokeIngress; len(v) > 0 {
+		input := &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: aws.StringSlice(v),
+		}
+
+		log.Printf("[DEBUG] Revoking VPC Security Group (%s) ingress rules: %s", groupID, strings.Join(v, ", "))
+		_, err := conn.RevokeSecurityGroupIngressWithContext(ctx, input)
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, errCodeInvalidPermissionNotFound, errCodeInvalidSecurityGroupRuleIdNotFound) {
+			return sdkdiag.AppendErrorf(diags, "revoking VPC Security Group (%s) ingress rules: %s", groupID, err)
+		}
+	}
+
+	if v := changes.revokeEgress; len(v) > 0 {
+		input := &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: aws.StringSlice(v),
+		}
+
+		log.Printf("[DEBUG] Revoking VPC Security Group (%s) egress rules: %s", groupID, strings.Join(v, ", "))
+		_, err := conn.RevokeSecurityGroupEgressWithContext(ctx, input)
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, errCodeInvalidPermissionNotFound, errCodeInvalidSecurityGroupRuleIdNotFound) {
+			return sdkdiag.AppendErrorf(diags, "revoking VPC Security Group (%s) egress rules: %s", groupID, err)
+		}
+	}
+
+	d.SetId(groupID)
+
+	return append(diags, resourceSecurityGroupRulesExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceSecurityGroupRulesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	_, err := FindSecurityGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Security Group Rules Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Security Group Rules Exclusive (%s): %s", d.Id(), err)
+	}
+
+	rules, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Security Group Rules Exclusive (%s): %s", d.Id(), err)
+	}
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	var egress, ingress []interface{}
+	for _, rule := range rules {
+		if aws.BoolValue(rule.IsEgress) {
+			egress = append(egress, flattenSecurityGroupRuleExclusive(rule, accountID))
+		} else {
+			ingress = append(ingress, flattenSecurityGroupRuleExclusive(rule, accountID))
+		}
+	}
+
+	if err := d.Set("egress", egress); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting egress: %s", err)
+	}
+	if err := d.Set("ingress", ingress); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ingress: %s", err)
+	}
+	d.Set("security_group_id", d.Id())
+
+	return diags
+}
+
+func resourceSecurityGroupRulesExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Removing exclusive management leaves the current rules in place.
+	log.Printf("[DEBUG] Removing VPC Security Group Rules Exclusive (%s) from state", d.Id())
+
+	return nil
+}
+
+// securityGroupRuleExclusive is a single desired rule object, i.e. one protocol and port range with exactly one peer.
+type securityGroupRuleExclusive struct {
+	cidrIPv4                  string
+	cidrIPv6                  string
+	description               string
+	egress                    bool
+	fromPort                  int64
+	ipProtocol                string
+	prefixListID              string
+	referencedSecurityGroupID string
+	toPort                    int64
+}
+
+// key identifies a rule independently of its description.
+// Two rules with the same key are the same rule object in EC2.
+func (r securityGroupRuleExclusive) key() string {
+	direction := "ingress"
+	if r.egress {
+		direction = "egress"
+	}
+
+	var peer string
+	switch {
+	case r.cidrIPv4 != "":
+		peer = "cidr_ipv4=" + r.cidrIPv4
+	case r.cidrIPv6 != "":
+		peer = "cidr_ipv6=" + r.cidrIPv6
+	case r.prefixListID != "":
+		peer = "prefix_list_id=" + r.prefixListID
+	case r.referencedSecurityGroupID != "":
+		peer = "referenced_security_group_id=" + r.referencedSecurityGroupID
+	}
+
+	return fmt.Sprintf("%s-%s-%d-%d-%s", direction, ProtocolForValue(r.ipProtocol), r.fromPort, r.toPort, peer)
+}
+
+func (r securityGroupRuleExclusive) ipPermission() *ec2.IpPermission {
+	apiObject := &ec2.IpPermission{
+		IpProtocol: aws.String(r.ipProtocol),
+	}
+
+	// Ports are not allowed for the 'ALL' "-1" protocol.
+	if ProtocolForValue(r.ipProtocol) != "-1" {
+		apiObject.FromPort = aws.Int64(r.fromPort)
+		apiObject.ToPort = aws.Int64(r.toPort)
+	}
+
+	var description *string
+	if r.description != "" {
+		description = aws.String(r.description)
+	}
+
+	switch {
+	case r.cidrIPv4 != "":
+		apiObject.IpRanges = []*ec2.IpRange{{
+			CidrIp:      aws.String(r.cidrIPv4),
+			Description: description,
+		}}
+	case r.cidrIPv6 != "":
+		apiObject.Ipv6Ranges = []*ec2.Ipv6Range{{
+			CidrIpv6:    aws.String(r.cidrIPv6),
+			Description: description,
+		}}
+	case r.prefixListID != "":
+		apiObject.PrefixListIds = []*ec2.PrefixListId{{
+			Description:  description,
+			PrefixListId: aws.String(r.prefixListID),
+		}}
+	case r.referencedSecurityGroupID != "":
+		pair := &ec2.UserIdGroupPair{
+			Description: description,
+		}
+
+		// [UserID/]GroupID.
+		if parts := strings.Split(r.referencedSecurityGroupID, "/"); len(parts) == 2 {
+			pair.GroupId = aws.String(parts[1])
+			pair.UserId = aws.String(parts[0])
+		} else {
+			pair.GroupId = aws.String(r.referencedSecurityGroupID)
+		}
+
+		apiObject.UserIdGroupPairs = []*ec2.UserIdGroupPair{pair}
+	}
+
+	return apiObject
+}
+
+func (r securityGroupRuleExclusive) securityGroupRuleRequest() *ec2.SecurityGroupRuleRequest {
+	apiObject := &ec2.SecurityGroupRuleRequest{
+		Description: aws.String(r.description),
+		IpProtocol:  aws.String(r.ipProtocol),
+	}
+
+	if ProtocolForValue(r.ipProtocol) != "-1" {
+		apiObject.FromPort = aws.Int64(r.fromPort)
+		apiObject.ToPort = aws.Int64(r.toPort)
+	}
+
+	switch {
+	case r.cidrIPv4 != "":
+		apiObject.CidrIpv4 = aws.String(r.cidrIPv4)
+	case r.cidrIPv6 != "":
+		apiObject.CidrIpv6 = aws.String(r.cidrIPv6)
+	case r.prefixListID != "":
+		apiObject.PrefixListId = aws.String(r.prefixListID)
+	case r.referencedSecurityGroupID != "":
+		// [UserID/]GroupID.
+		if parts := strings.Split(r.referencedSecurityGroupID, "/"); len(parts) == 2 {
+			apiObject.ReferencedGroupId = aws.String(parts[1])
+		} else {
+			apiObject.ReferencedGroupId = aws.String(r.referencedSecurityGroupID)
+		}
+	}
+
+	return apiObject
+}
+
+func expandSecurityGroupRulesExclusive(ingress, egress []interface{}) (map[string]securityGroupRuleExclusive, error) {
+	rules := make(map[string]securityGroupRuleExclusive)
+
+	for _, v := range []struct {
+		egress bool
+		tfList []interface{}
+	}{
+		{false, ingress},
+		{true, egress},
+	} {
+		for _, tfMapRaw := range v.tfList {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			rule := securityGroupRuleExclusive{
+				cidrIPv4:                  tfMap["cidr_ipv4"].(string),
+				cidrIPv6:                  tfMap["cidr_ipv6"].(string),
+				description:               tfMap["description"].(string),
+				egress:                    v.egress,
+				fromPort:                  int64(tfMap["from_port"].(int)),
+				ipProtocol:                ProtocolForValue(tfMap["ip_protocol"].(string)),
+				prefixListID:              tfMap["prefix_list_id"].(string),
+				referencedSecurityGroupID: tfMap["referenced_security_group_id"].(string),
+				toPort:                    int64(tfMap["to_port"].(int)),
+			}
+
+			var n int
+			for _, v := range []string{rule.cidrIPv4, rule.cidrIPv6, rule.prefixListID, rule.referencedSecurityGroupID} {
+				if v != "" {
+					n++
+				}
+			}
+			if n != 1 {
+				return nil, fmt.Errorf("exactly one of cidr_ipv4, cidr_ipv6, prefix_list_id or referenced_security_group_id must be set in each rule (%s)", rule.key())
+			}
+
+			if rule.ipProtocol == "-1" && (rule.fromPort != 0 || rule.toPort != 0) {
+				return nil, fmt.Errorf("from_port (%d) and to_port (%d) must both be 0 to use the 'ALL' \"-1\" protocol", rule.fromPort, rule.toPort)
+			}
+
+			k := rule.key()
+			if _, ok := rules[k]; ok {
+				return nil, fmt.Errorf("duplicate rule (%s)", k)
+			}
+			rules[k] = rule
+		}
+	}
+
+	return rules, nil
+}
+
+// securityGroupRulesExclusiveChanges is the minimal set of API operations that
+// converge a security group's rules to the desired rule set.
+type securityGroupRulesExclusiveChanges struct {
+	authorizeEgress  []*ec2.IpPermission
+	authorizeIngress []*ec2.IpPermission
+	modify           []*ec2.SecurityGroupRuleUpdate
+	revokeEgress     []string
+	revokeIngress    []string
+}
+
+// diffSecurityGroupRulesExclusive compares the rules returned by DescribeSecurityGroupRules with the desired rules.
+// Rules present in both with only a different description are modified in place rather than replaced.
+func diffSecurityGroupRulesExclusive(have []*ec2.SecurityGroupRule, want map[string]securityGroupRuleExclusive, accountID string) securityGroupRulesExclusiveChanges {
+	var changes securityGroupRulesExclusiveChanges
+	seen := make(map[string]struct{})
+
+	for _, apiObject := range have {
+		rule := securityGroupRuleExclusiveFromAPIObject(apiObject, accountID)
+		k := rule.key()
+		ruleID := aws.StringValue(apiObject.SecurityGroupRuleId)
+
+		if v, ok := want[k]; ok {
+			if _, ok := seen[k]; !ok {
+				seen[k] = struct{}{}
+
+				if v.description != rule.description {
+					changes.modify = append(changes.modify, &ec2.SecurityGroupRuleUpdate{
+						SecurityGroupRule:   v.securityGroupRuleRequest(),
+						SecurityGroupRuleId: aws.String(ruleID),
+					})
+				}
+
+				continue
+			}
+		}
+
+		if rule.egress {
+			changes.revokeEgress = append(changes.revokeEgress, ruleID)
+		} else {
+			changes.revokeIngress = append(changes.revokeIngress, ruleID)
+		}
+	}
+
+	keys := make([]string, 0, len(want))
+	for k := range want {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if _, ok := seen[k]; ok {
+			continue
+		}
+
+		if rule := want[k]; rule.egress {
+			changes.authorizeEgress = append(changes.authorizeEgress, rule.ipPermission())
+		} else {
+			changes.authorizeIngress = append(changes.authorizeIngress, rule.ipPermission())
+		}
+	}
+
+	return changes
+}
+
+func securityGroupRuleExclusiveFromAPIObject(apiObject *ec2.SecurityGroupRule, accountID string) securityGroupRuleExclusive {
+	rule := securityGroupRuleExclusive{
+		cidrIPv4:     aws.StringValue(apiObject.CidrIpv4),
+		cidrIPv6:     aws.StringValue(apiObject.CidrIpv6),
+		description:  aws.StringValue(apiObject.Description),
+		egress:       aws.BoolValue(apiObject.IsEgress),
+		ipProtocol:   ProtocolForValue(aws.StringValue(apiObject.IpProtocol)),
+		prefixListID: aws.StringValue(apiObject.PrefixListId),
+	}
+
+	// Ports are reported as -1 for the 'ALL' "-1" protocol but must be configured as 0.
+	if rule.ipProtocol != "-1" {
+		rule.fromPort = aws.Int64Value(apiObject.FromPort)
+		rule.toPort = aws.Int64Value(apiObject.ToPort)
+	}
+
+	if v := apiObject.ReferencedGroupInfo; v != nil {
+		// [UserID/]GroupID.
+		if userID := aws.StringValue(v.UserId); userID == "" || userID == accountID {
+			rule.referencedSecurityGroupID = aws.StringValue(v.GroupId)
+		} else {
+			rule.referencedSecurityGroupID = userID + "/" + aws.StringValue(v.GroupId)
+		}
+	}
+
+	return rule
+}
+
+// securityGroupRuleExclusiveHash hashes a rule block, treating IP protocol names and numbers as equivalent.
+func securityGroupRuleExclusiveHash(v interface{}) int {
+	tfMap := v.(map[string]interface{})
+
+	return create.StringHashcode(fmt.Sprintf("%s-%d-%d-%s-%s-%s-%s-%s",
+		ProtocolForValue(tfMap["ip_protocol"].(string)),
+		tfMap["from_port"].(int),
+		tfMap["to_port"].(int),
+		tfMap["cidr_ipv4"].(string),
+		tfMap["cidr_ipv6"].(string),
+		tfMap["prefix_list_id"].(string),
+		tfMap["referenced_security_group_id"].(string),
+		tfMap["description"].(string),
+	))
+}
+
+func flattenSecurityGroupRuleExclusive(apiObject *ec2.SecurityGroupRule, accountID string) map[string]interface{} {
+	rule := securityGroupRuleExclusiveFromAPIObject(apiObject, accountID)
+
+	return map[string]interface{}{
+		"cidr_ipv4":                    rule.cidrIPv4,
+		"cidr_ipv6":                    rule.cidrIPv6,
+		"description":                  rule.description,
+		"from_port":                    int(rule.fromPort),
+		"ip_protocol":                  rule.ipProtocol,
+		"prefix_list_id":               rule.prefixListID,
+		"referenced_security_group_id": rule.referencedSecurityGroupID,
+		"to_port":                      int(rule.toPort),
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestExpandSecurityGroupRulesExclusive(t *testing.T) {
+	t.Parallel()
+
+	rule := func(protocol string, fromPort, toPort int, cidrIPv4, referencedSecurityGroupID string) map[string]interface{} {
+		return map[string]interface{}{
+			"cidr_ipv4":                    cidrIPv4,
+			"cidr_ipv6":                    "",
+			"description":                  "",
+			"from_port":                    fromPort,
+			"ip_protocol":                  protocol,
+			"prefix_list_id":               "",
+			"referenced_security_group_id": referencedSecurityGroupID,
+			"to_port":                      toPort,
+		}
+	}
+
+	testCases := []struct {
+		Name          string
+		Ingress       []interface{}
+		Egress        []interface{}
+		ExpectedKeys  []string
+		ExpectedError bool
+	}{
+		{
+			Name:    "ingress and egress",
+			Ingress: []interface{}{rule("6", 443, 443, "10.0.0.0/16", "")},
+			Egress:  []interface{}{rule("-1", 0, 0, "0.0.0.0/0", "")},
+			ExpectedKeys: []string{
+				"egress--1-0-0-cidr_ipv4=0.0.0.0/0",
+				"ingress-tcp-443-443-cidr_ipv4=10.0.0.0/16",
+			},
+		},
+		{
+			Name:          "no peer",
+			Ingress:       []interface{}{rule("tcp", 443, 443, "", "")},
+			ExpectedError: true,
+		},
+		{
+			Name:          "multiple peers",
+			Ingress:       []interface{}{rule("tcp", 443, 443, "10.0.0.0/16", "sg-12345678")},
+			ExpectedError: true,
+		},
+		{
+			Name:          "all protocols with ports",
+			Egress:        []interface{}{rule("-1", 80, 80, "0.0.0.0/0", "")},
+			ExpectedError: true,
+		},
+		{
+			Name: "duplicate",
+			Ingress: []interface{}{
+				rule("tcp", 22, 22, "10.0.0.0/16", ""),
+				rule("6", 22, 22, "10.0.0.0/16", ""),
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandSecurityGroupRulesExclusive(testCase.Ingress, testCase.Egress)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var keys []string
+			for k := range got {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			if !reflect.DeepEqual(keys, testCase.ExpectedKeys) {
+				t.Errorf("got %v, expected %v", keys, testCase.ExpectedKeys)
+			}
+		})
+	}
+}
+
+func TestDiffSecurityGroupRulesExclusive(t *testing.T) {
+	t.Parallel()
+
+	const accountID = "123456789012"
+
+	have := []*ec2.SecurityGroupRule{
+		{
+			// Unchanged.
+			CidrIpv4:            aws.String("10.0.0.0/16"),
+			FromPort:            aws.Int64(443),
+			IpProtocol:          aws.String("tcp"),
+			IsEgress:            aws.Bool(false),
+			SecurityGroupRuleId: aws.String("sgr-00000001"),
+			ToPort:              aws.Int64(443),
+		},
+		{
+			// Description changed.
+			Description: aws.String("old"),
+			FromPort:    aws.Int64(22),
+			IpProtocol:  aws.String("tcp"),
+			IsEgress:    aws.Bool(false),
+			ReferencedGroupInfo: &ec2.ReferencedSecurityGroup{
+				GroupId: aws.String("sg-12345678"),
+				UserId:  aws.String(accountID),
+			},
+			SecurityGroupRuleId: aws.String("sgr-00000002"),
+			ToPort:              aws.Int64(22),
+		},
+		{
+			// Out of band.
+			CidrIpv4:            aws.String("0.0.0.0/0"),
+			FromPort:            aws.Int64(80),
+			IpProtocol:          aws.String("tcp"),
+			IsEgress:            aws.Bool(false),
+			SecurityGroupRuleId: aws.String("sgr-00000003"),
+			ToPort:              aws.Int64(80),
+		},
+		{
+			// Default egress rule.
+			CidrIpv4:            aws.String("0.0.0.0/0"),
+			FromPort:            aws.Int64(-1),
+			IpProtocol:          aws.String("-1"),
+			IsEgress:            aws.Bool(true),
+			SecurityGroupRuleId: aws.String("sgr-00000004"),
+			ToPort:              aws.Int64(-1),
+		},
+	}
+
+	want, err := expandSecurityGroupRulesExclusive([]interface{}{
+		map[string]interface{}{
+			"cidr_ipv4":                    "10.0.0.0/16",
+			"cidr_ipv6":                    "",
+			"description":                  "",
+			"from_port":                    443,
+			"ip_protocol":                  "6",
+			"prefix_list_id":               "",
+			"referenced_security_group_id": "",
+			"to_port":                      443,
+		},
+		map[string]interface{}{
+			"cidr_ipv4":                    "",
+			"cidr_ipv6":                    "",
+			"description":                  "new",
+			"from_port":                    22,
+			"ip_protocol":                  "tcp",
+			"prefix_list_id":               "",
+			"referenced_security_group_id": "sg-12345678",
+			"to_port":                      22,
+		},
+	}, []interface{}{
+		map[string]interface{}{
+			"cidr_ipv4":                    "",
+			"cidr_ipv6":                    "::/0",
+			"description":                  "",
+			"from_port":                    0,
+			"ip_protocol":                  "-1",
+			"prefix_list_id":               "",
+			"referenced_security_group_id": "",
+			"to_port":                      0,
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := diffSecurityGroupRulesExclusive(have, want, accountID)
+
+	if len(got.authorizeIngress) != 0 {
+		t.Errorf("authorizeIngress: got %d rules, expected 0", len(got.authorizeIngress))
+	}
+
+	if len(got.authorizeEgress) != 1 {
+		t.Fatalf("authorizeEgress: got %d rules, expected 1", len(got.authorizeEgress))
+	}
+	if v := got.authorizeEgress[0]; aws.StringValue(v.IpProtocol) != "-1" || v.FromPort != nil || v.ToPort != nil || len(v.Ipv6Ranges) != 1 {
+		t.Errorf("authorizeEgress: unexpected rule %s", v)
+	}
+
+	if len(got.modify) != 1 {
+		t.Fatalf("modify: got %d rules, expected 1", len(got.modify))
+	}
+	if v := got.modify[0]; aws.StringValue(v.SecurityGroupRuleId) != "sgr-00000002" || aws.StringValue(v.SecurityGroupRule.Description) != "new" || aws.StringValue(v.SecurityGroupRule.ReferencedGroupId) != "sg-12345678" {
+		t.Errorf("modify: unexpected update %s", v)
+	}
+
+	if expected := []string{"sgr-00000003"}; !reflect.DeepEqual(got.revokeIngress, expected) {
+		t.Errorf("revokeIngress: got %v, expected %v", got.revokeIngress, expected)
+	}
+
+	if expected := []string{"sgr-00000004"}; !reflect.DeepEqual(got.revokeEgress, expected) {
+		t.Errorf("revokeEgress: got %v, expected %v", got.revokeEgress, expected)
+	}
+}
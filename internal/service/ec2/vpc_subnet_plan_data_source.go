@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_vpc_subnet_plan")
+func DataSourceVPCSubnetPlan() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVPCSubnetPlanRead,
+
+		Schema: map[string]*schema.Schema{
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+			},
+			"reserved_availability_zones": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"reserved_cidr_blocks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+				},
+			},
+			"subnets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tier": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"netmask_length": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(SubnetCIDRMinIPv4, SubnetCIDRMaxIPv4),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVPCSubnetPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	cidrBlock := d.Get("cidr_block").(string)
+
+	if err := itypes.ValidateCIDRBlock(cidrBlock); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	plan := &subnetPlan{
+		availabilityZones: flex.ExpandStringValueList(d.Get("availability_zones").([]interface{})),
+		cidrBlock:         cidrBlock,
+		reservedSlots:     d.Get("reserved_availability_zones").(int),
+	}
+
+	if v, ok := d.GetOk("reserved_cidr_blocks"); ok {
+		plan.reservedCIDRBlocks = flex.ExpandStringValueList(v.([]interface{}))
+	}
+
+	for _, tfMapRaw := range d.Get("tier").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		plan.tiers = append(plan.tiers, subnetPlanTier{
+			name:          tfMap["name"].(string),
+			netmaskLength: tfMap["netmask_length"].(int),
+		})
+	}
+
+	subnets, err := plan.allocate()
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "planning subnets for %s: %s", cidrBlock, err)
+	}
+
+	d.SetId(itypes.CanonicalCIDRBlock(cidrBlock))
+
+	tfList := make([]interface{}, 0, len(subnets))
+	for _, v := range subnets {
+		tfList = append(tfList, map[string]interface{}{
+			"availability_zone": v.availabilityZone,
+			"cidr_block":        v.cidrBlock,
+			"tier":              v.tier,
+		})
+	}
+
+	if err := d.Set("subnets", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting subnets: %s", err)
+	}
+
+	return diags
+}
+
+type subnetPlanTier struct {
+	name          string
+	netmaskLength int
+}
+
+type subnetPlan struct {
+	availabilityZones  []string
+	cidrBlock          string
+	reservedCIDRBlocks []string
+	// Number of subnets reserved per tier. Defaults to the number of availability zones.
+	reservedSlots int
+	tiers         []subnetPlanTier
+}
+
+type subnetPlanSubnet struct {
+	availabilityZone string
+	cidrBlock        string
+	tier             string
+}
+
+// allocate carves the VPC CIDR block into one subnet per tier per availability zone.
+// Tiers are allocated in order, each subnet at the lowest free, naturally aligned block of its size.
+// Allocations only depend on the tiers, availability zone slots and reserved CIDR blocks before them,
+// so appending tiers or (within reserved_availability_zones) availability zones never moves existing subnets.
+func (p *subnetPlan) allocate() ([]subnetPlanSubnet, error) {
+	vpcPrefix, err := netip.ParsePrefix(p.cidrBlock)
+
+	if err != nil {
+		return nil, err
+	}
+
+	slots := len(p.availabilityZones)
+	if p.reservedSlots > 0 {
+		if p.reservedSlots < slots {
+			return nil, fmt.Errorf("reserved_availability_zones (%d) is less than the number of availability zones (%d)", p.reservedSlots, slots)
+		}
+		slots = p.reservedSlots
+	}
+
+	seen := make(map[string]struct{})
+	for _, az := range p.availabilityZones {
+		if _, ok := seen[az]; ok {
+			return nil, fmt.Errorf("duplicate availability zone (%s)", az)
+		}
+		seen[az] = struct{}{}
+	}
+
+	allocated := append([]string(nil), p.reservedCIDRBlocks...)
+
+	seen = make(map[string]struct{})
+	var subnets []subnetPlanSubnet
+
+	for _, tier := range p.tiers {
+		if _, ok := seen[tier.name]; ok {
+			return nil, fmt.Errorf("duplicate tier (%s)", tier.name)
+		}
+		seen[tier.name] = struct{}{}
+
+		if tier.netmaskLength < vpcPrefix.Bits() {
+			return nil, fmt.Errorf("tier (%s) netmask length (%d) is shorter than the VPC CIDR block netmask length (%d)", tier.name, tier.netmaskLength, vpcPrefix.Bits())
+		}
+
+		for slot := 0; slot < slots; slot++ {
+			cidrBlock, ok, err := itypes.CIDRBlockFirstFit(p.cidrBlock, tier.netmaskLength, allocated)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if !ok {
+				return nil, fmt.Errorf("insufficient free space for tier (%s) subnet %d of %d with netmask length %d", tier.name, slot+1, slots, tier.netmaskLength)
+			}
+
+			allocated = append(allocated, cidrBlock)
+
+			// Reserved slots are allocated but not returned.
+			if slot < len(p.availabilityZones) {
+				subnets = append(subnets, subnetPlanSubnet{
+					availabilityZone: p.availabilityZones[slot],
+					cidrBlock:        cidrBlock,
+					tier:             tier.name,
+				})
+			}
+		}
+	}
+
+	return subnets, nil
+}
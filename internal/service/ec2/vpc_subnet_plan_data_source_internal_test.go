@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSubnetPlanAllocate(t *testing.T) {
+	t.Parallel()
+
+	azs := []string{"az-a", "az-b", "az-c"}
+
+	cidrBlocks := func(subnets []subnetPlanSubnet) []string {
+		var cidrBlocks []string
+		for _, v := range subnets {
+			cidrBlocks = append(cidrBlocks, v.tier+":"+v.availabilityZone+":"+v.cidrBlock)
+		}
+		return cidrBlocks
+	}
+
+	testCases := []struct {
+		Name          string
+		Plan          subnetPlan
+		Expected      []string
+		ExpectedError bool
+	}{
+		{
+			Name: "basic",
+			Plan: subnetPlan{
+				availabilityZones: azs[:2],
+				cidrBlock:         "10.0.0.0/16",
+				tiers: []subnetPlanTier{
+					{name: "public", netmaskLength: 24},
+					{name: "private", netmaskLength: 20},
+				},
+			},
+			Expected: []string{
+				"public:az-a:10.0.0.0/24",
+				"public:az-b:10.0.1.0/24",
+				"private:az-a:10.0.16.0/20",
+				"private:az-b:10.0.32.0/20",
+			},
+		},
+		{
+			Name: "appended tier fills gap",
+			Plan: subnetPlan{
+				availabilityZones: azs[:2],
+				cidrBlock:         "10.0.0.0/16",
+				tiers: []subnetPlanTier{
+					{name: "public", netmaskLength: 24},
+					{name: "private", netmaskLength: 20},
+					{name: "database", netmaskLength: 24},
+				},
+			},
+			Expected: []string{
+				"public:az-a:10.0.0.0/24",
+				"public:az-b:10.0.1.0/24",
+				"private:az-a:10.0.16.0/20",
+				"private:az-b:10.0.32.0/20",
+				"database:az-a:10.0.2.0/24",
+				"database:az-b:10.0.3.0/24",
+			},
+		},
+		{
+			Name: "reserved availability zones",
+			Plan: subnetPlan{
+				availabilityZones: azs[:2],
+				cidrBlock:         "10.0.0.0/16",
+				reservedSlots:     3,
+				tiers: []subnetPlanTier{
+					{name: "public", netmaskLength: 24},
+					{name: "private", netmaskLength: 24},
+				},
+			},
+			Expected: []string{
+				"public:az-a:10.0.0.0/24",
+				"public:az-b:10.0.1.0/24",
+				"private:az-a:10.0.3.0/24",
+				"private:az-b:10.0.4.0/24",
+			},
+		},
+		{
+			Name: "reserved availability zone added",
+			Plan: subnetPlan{
+				availabilityZones: azs,
+				cidrBlock:         "10.0.0.0/16",
+				reservedSlots:     3,
+				tiers: []subnetPlanTier{
+					{name: "public", netmaskLength: 24},
+					{name: "private", netmaskLength: 24},
+				},
+			},
+			Expected: []string{
+				"public:az-a:10.0.0.0/24",
+				"public:az-b:10.0.1.0/24",
+				"public:az-c:10.0.2.0/24",
+				"private:az-a:10.0.3.0/24",
+				"private:az-b:10.0.4.0/24",
+				"private:az-c:10.0.5.0/24",
+			},
+		},
+		{
+			Name: "reserved CIDR blocks",
+			Plan: subnetPlan{
+				availabilityZones:  azs[:1],
+				cidrBlock:          "10.0.0.0/24",
+				reservedCIDRBlocks: []string{"10.0.0.0/26", "10.0.0.128/27"},
+				tiers: []subnetPlanTier{
+					{name: "a", netmaskLength: 26},
+					{name: "b", netmaskLength: 27},
+				},
+			},
+			Expected: []string{
+				"a:az-a:10.0.0.64/26",
+				"b:az-a:10.0.0.160/27",
+			},
+		},
+		{
+			Name: "insufficient space",
+			Plan: subnetPlan{
+				availabilityZones: azs,
+				cidrBlock:         "10.0.0.0/24",
+				tiers: []subnetPlanTier{
+					{name: "public", netmaskLength: 25},
+				},
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "fewer reserved than availability zones",
+			Plan: subnetPlan{
+				availabilityZones: azs,
+				cidrBlock:         "10.0.0.0/16",
+				reservedSlots:     2,
+				tiers: []subnetPlanTier{
+					{name: "public", netmaskLength: 24},
+				},
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "duplicate tier",
+			Plan: subnetPlan{
+				availabilityZones: azs,
+				cidrBlock:         "10.0.0.0/16",
+				tiers: []subnetPlanTier{
+					{name: "public", netmaskLength: 24},
+					{name: "public", netmaskLength: 24},
+				},
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "tier larger than VPC",
+			Plan: subnetPlan{
+				availabilityZones: azs,
+				cidrBlock:         "10.0.0.0/24",
+				tiers: []subnetPlanTier{
+					{name: "public", netmaskLength: 20},
+				},
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.Plan.allocate()
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := cidrBlocks(got); !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCSubnetPlanDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_vpc_subnet_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSubnetPlanDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.#", "4"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subnets.0.availability_zone", "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.0.cidr_block", "10.0.0.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.0.tier", "public"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.1.cidr_block", "10.0.1.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.2.cidr_block", "10.0.16.0/20"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.2.tier", "private"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subnets.3.availability_zone", "data.aws_availability_zones.available", "names.1"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.3.cidr_block", "10.0.32.0/20"),
+				),
+			},
+			{
+				Config: testAccVPCSubnetPlanDataSourceConfig_reserved,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.0.cidr_block", "10.0.1.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.1.cidr_block", "10.0.2.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.2.cidr_block", "10.0.16.0/20"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.3.cidr_block", "10.0.32.0/20"),
+				),
+			},
+		},
+	})
+}
+
+var testAccVPCSubnetPlanDataSourceConfig_basic = acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), `
+data "aws_vpc_subnet_plan" "test" {
+  cidr_block         = "10.0.0.0/16"
+  availability_zones = slice(data.aws_availability_zones.available.names, 0, 2)
+
+  tier {
+    name           = "public"
+    netmask_length = 24
+  }
+
+  tier {
+    name           = "private"
+    netmask_length = 20
+  }
+}
+`)
+
+var testAccVPCSubnetPlanDataSourceConfig_reserved = acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), `
+data "aws_vpc_subnet_plan" "test" {
+  cidr_block                  = "10.0.0.0/16"
+  availability_zones          = slice(data.aws_availability_zones.available.names, 0, 2)
+  reserved_availability_zones = 3
+  reserved_cidr_blocks        = ["10.0.0.0/24"]
+
+  tier {
+    name           = "public"
+    netmask_length = 24
+  }
+
+  tier {
+    name           = "private"
+    netmask_length = 20
+  }
+}
+`)
@@ -14,6 +14,7 @@ import (
 	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
@@ -560,6 +561,38 @@ func WaitInstanceStarted(ctx context.Context, conn *ec2.EC2, id string, timeout
 	return nil, err
 }
 
+// waitInstanceStartedWithCapacityCheck waits for an instance to start, failing as soon as the instance
+// returns to the stopped state because there is insufficient capacity for its instance type.
+func waitInstanceStartedWithCapacityCheck(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Instance, error) {
+	refresh := StatusInstanceState(ctx, conn, id)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.InstanceStateNamePending, ec2.InstanceStateNameStopped},
+		Target:  []string{ec2.InstanceStateNameRunning},
+		Refresh: func() (interface{}, string, error) {
+			outputRaw, state, err := refresh()
+
+			if output, ok := outputRaw.(*ec2.Instance); ok && state == ec2.InstanceStateNameStopped {
+				if v := output.StateReason; v != nil && aws.StringValue(v.Code) == errCodeServerInsufficientInstanceCapacity {
+					return output, state, awserr.New(errCodeInsufficientInstanceCapacity, aws.StringValue(v.Message), nil)
+				}
+			}
+
+			return outputRaw, state, err
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.Instance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitInstanceStopped(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Instance, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
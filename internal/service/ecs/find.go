@@ -157,3 +157,88 @@ func FindService(ctx context.Context, conn *ecs.ECS, input *ecs.DescribeServices
 
 	return output.Services[0], nil
 }
+
+func findPrimaryServiceDeployment(deployments []*ecs.Deployment) *ecs.Deployment {
+	for _, v := range deployments {
+		if aws.StringValue(v.Status) == serviceDeploymentStatusPrimary {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func findServiceDeploymentByID(deployments []*ecs.Deployment, id string) *ecs.Deployment {
+	for _, v := range deployments {
+		if aws.StringValue(v.Id) == id {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func findStoppedServiceTasks(ctx context.Context, conn *ecs.ECS, service *ecs.Service, maxResults int64) ([]*ecs.Task, error) {
+	output, err := conn.ListTasksWithContext(ctx, &ecs.ListTasksInput{
+		Cluster:       service.ClusterArn,
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		MaxResults:    aws.Int64(maxResults),
+		ServiceName:   service.ServiceName,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TaskArns) == 0 {
+		return nil, nil
+	}
+
+	input := &ecs.DescribeTasksInput{
+		Cluster: service.ClusterArn,
+		Tasks:   output.TaskArns,
+	}
+
+	tasks, err := conn.DescribeTasksWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks.Tasks, nil
+}
+
+func FindTaskByTwoPartKey(ctx context.Context, conn *ecs.ECS, taskARN, cluster string) (*ecs.Task, error) {
+	input := &ecs.DescribeTasksInput{
+		Cluster: aws.String(cluster),
+		Tasks:   aws.StringSlice([]string{taskARN}),
+	}
+
+	output, err := conn.DescribeTasksWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Stopped tasks are only retained for a short time, after which DescribeTasks returns a "MISSING" failure.
+	for _, v := range output.Failures {
+		if aws.StringValue(v.Reason) == "MISSING" {
+			return nil, &retry.NotFoundError{
+				LastRequest: input,
+			}
+		}
+	}
+
+	if output == nil || len(output.Tasks) == 0 || output.Tasks[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Tasks[0], nil
+}
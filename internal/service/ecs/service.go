@@ -414,6 +414,57 @@ func ResourceService() *schema.Resource {
 										Type:     schema.TypeString,
 										Required: true,
 									},
+									"timeout": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"idle_timeout_seconds": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"per_request_timeout_seconds": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+											},
+										},
+									},
+									"tls": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"issuer_cert_authority": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"aws_pca_authority_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: verify.ValidARN,
+															},
+														},
+													},
+												},
+												"kms_key": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -462,6 +513,27 @@ func ResourceService() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"volume_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_ebs_volume": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: managedEBSVolumeSchema(false),
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"wait_for_steady_state": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -574,6 +646,10 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.ServiceConnectConfiguration = expandServiceConnectConfiguration(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("volume_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.VolumeConfigurations = expandServiceVolumeConfigurations(v.([]interface{}))
+	}
+
 	serviceRegistries := d.Get("service_registries").([]interface{})
 	if len(serviceRegistries) > 0 {
 		srs := make([]*ecs.ServiceRegistry, 0, len(serviceRegistries))
@@ -772,6 +848,13 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "setting service_registries: %s", err)
 	}
 
+	// Volume configurations are only returned on the service's deployments.
+	if deployment := findPrimaryServiceDeployment(service.Deployments); deployment != nil {
+		if err := d.Set("volume_configuration", flattenServiceVolumeConfigurations(deployment.VolumeConfigurations)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting volume_configuration: %s", err)
+		}
+	}
+
 	setTagsOut(ctx, service.Tags)
 
 	return diags
@@ -913,6 +996,14 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.TaskDefinition = aws.String(d.Get("task_definition").(string))
 		}
 
+		if d.HasChange("volume_configuration") {
+			input.VolumeConfigurations = expandServiceVolumeConfigurations(d.Get("volume_configuration").([]interface{}))
+			if input.VolumeConfigurations == nil {
+				// An empty list removes the service's volume configurations.
+				input.VolumeConfigurations = []*ecs.ServiceVolumeConfiguration{}
+			}
+		}
+
 		// Retry due to IAM eventual consistency
 		err := retry.RetryContext(ctx, propagationTimeout+serviceUpdateTimeout, func() *retry.RetryError {
 			_, err := conn.UpdateServiceWithContext(ctx, input)
@@ -1440,6 +1531,12 @@ func expandServices(srv []interface{}) []*ecs.ServiceConnectService {
 		if v, ok := raw["port_name"].(string); ok && v != "" {
 			config.PortName = aws.String(v)
 		}
+		if v, ok := raw["timeout"].([]interface{}); ok && len(v) > 0 {
+			config.Timeout = expandTimeoutConfiguration(v)
+		}
+		if v, ok := raw["tls"].([]interface{}); ok && len(v) > 0 {
+			config.Tls = expandServiceConnectTLSConfiguration(v)
+		}
 
 		out = append(out, &config)
 	}
@@ -1473,6 +1570,45 @@ func expandClientAliases(srv []interface{}) []*ecs.ServiceConnectClientAlias {
 	return out
 }
 
+func expandTimeoutConfiguration(tc []interface{}) *ecs.TimeoutConfiguration {
+	if len(tc) == 0 || tc[0] == nil {
+		return nil
+	}
+	raw := tc[0].(map[string]interface{})
+
+	config := &ecs.TimeoutConfiguration{}
+	if v, ok := raw["idle_timeout_seconds"].(int); ok && v != 0 {
+		config.IdleTimeoutSeconds = aws.Int64(int64(v))
+	}
+	if v, ok := raw["per_request_timeout_seconds"].(int); ok && v != 0 {
+		config.PerRequestTimeoutSeconds = aws.Int64(int64(v))
+	}
+
+	return config
+}
+
+func expandServiceConnectTLSConfiguration(tc []interface{}) *ecs.ServiceConnectTlsConfiguration {
+	if len(tc) == 0 || tc[0] == nil {
+		return nil
+	}
+	raw := tc[0].(map[string]interface{})
+
+	config := &ecs.ServiceConnectTlsConfiguration{}
+	if v, ok := raw["issuer_cert_authority"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config.IssuerCertificateAuthority = &ecs.ServiceConnectTlsCertificateAuthority{
+			AwsPcaAuthorityArn: aws.String(v[0].(map[string]interface{})["aws_pca_authority_arn"].(string)),
+		}
+	}
+	if v, ok := raw["kms_key"].(string); ok && v != "" {
+		config.KmsKey = aws.String(v)
+	}
+	if v, ok := raw["role_arn"].(string); ok && v != "" {
+		config.RoleArn = aws.String(v)
+	}
+
+	return config
+}
+
 func flattenServiceRegistries(srs []*ecs.ServiceRegistry) []map[string]interface{} {
 	if len(srs) == 0 {
 		return nil
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandServiceConnectConfiguration(t *testing.T) {
+	t.Parallel()
+
+	tfList := []interface{}{map[string]interface{}{
+		"enabled":   true,
+		"namespace": "example",
+		"service": []interface{}{map[string]interface{}{
+			"client_alias": []interface{}{map[string]interface{}{
+				"dns_name": "example.local",
+				"port":     8080,
+			}},
+			"discovery_name":        "example",
+			"ingress_port_override": 8443,
+			"port_name":             "http",
+			"timeout": []interface{}{map[string]interface{}{
+				"idle_timeout_seconds":        300,
+				"per_request_timeout_seconds": 60,
+			}},
+			"tls": []interface{}{map[string]interface{}{
+				"issuer_cert_authority": []interface{}{map[string]interface{}{
+					"aws_pca_authority_arn": "arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012", //lintignore:AWSAT003,AWSAT005
+				}},
+				"kms_key":  "alias/example",
+				"role_arn": "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+			}},
+		}},
+	}}
+
+	want := &ecs.ServiceConnectConfiguration{
+		Enabled:   aws.Bool(true),
+		Namespace: aws.String("example"),
+		Services: []*ecs.ServiceConnectService{{
+			ClientAliases: []*ecs.ServiceConnectClientAlias{{
+				DnsName: aws.String("example.local"),
+				Port:    aws.Int64(8080),
+			}},
+			DiscoveryName:       aws.String("example"),
+			IngressPortOverride: aws.Int64(8443),
+			PortName:            aws.String("http"),
+			Timeout: &ecs.TimeoutConfiguration{
+				IdleTimeoutSeconds:       aws.Int64(300),
+				PerRequestTimeoutSeconds: aws.Int64(60),
+			},
+			Tls: &ecs.ServiceConnectTlsConfiguration{
+				IssuerCertificateAuthority: &ecs.ServiceConnectTlsCertificateAuthority{
+					AwsPcaAuthorityArn: aws.String("arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012"), //lintignore:AWSAT003,AWSAT005
+				},
+				KmsKey:  aws.String("alias/example"),
+				RoleArn: aws.String("arn:aws:iam::123456789012:role/example"), //lintignore:AWSAT005
+			},
+		}},
+	}
+
+	got := expandServiceConnectConfiguration(tfList)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -86,6 +86,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceTag,
 			TypeName: "aws_ecs_tag",
 		},
+		{
+			Factory:  ResourceTask,
+			TypeName: "aws_ecs_task",
+			Name:     "Task",
+		},
 		{
 			Factory:  ResourceTaskDefinition,
 			TypeName: "aws_ecs_task_definition",
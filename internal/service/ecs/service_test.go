@@ -1137,6 +1137,40 @@ func TestAccECSService_LaunchTypeEC2_network(t *testing.T) {
 	})
 }
 
+func TestAccECSService_volumeConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_volumeConfiguration(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.name", "data"),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.managed_ebs_volume.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "volume_configuration.0.managed_ebs_volume.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.managed_ebs_volume.0.size_in_gb", "10"),
+				),
+			},
+			{
+				Config: testAccServiceConfig_volumeConfiguration(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "volume_configuration.0.managed_ebs_volume.0.size_in_gb", "20"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECSService_DaemonSchedulingStrategy_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service ecs.Service
@@ -1402,6 +1436,36 @@ func TestAccECSService_ServiceConnect_ingressPortOverride(t *testing.T) {
 	})
 }
 
+func TestAccECSService_ServiceConnect_tlsAndTimeout(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_serviceConnectTLSAndTimeout(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					testAccCheckServiceConnectTLSAndTimeout(&service, 300, 60),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.timeout.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.timeout.0.idle_timeout_seconds", "300"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.timeout.0.per_request_timeout_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.tls.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.0.service.0.tls.0.issuer_cert_authority.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_connect_configuration.0.service.0.tls.0.issuer_cert_authority.0.aws_pca_authority_arn", "aws_acmpca_certificate_authority.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_connect_configuration.0.service.0.tls.0.role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECSService_ServiceConnect_remove(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service ecs.Service
@@ -1865,6 +1929,79 @@ resource "aws_ecs_service" "test" {
 `, rName, desiredCount, waitForSteadyState))
 }
 
+func testAccServiceConfig_volumeConfiguration(rName string, sizeInGB int) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateBase(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "ecs.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonECSInfrastructureRolePolicyForVolumes"
+}
+
+resource "aws_ecs_task_definition" "ebs" {
+  family                   = "%[1]s-ebs"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = jsonencode([{
+    name      = "app"
+    image     = "public.ecr.aws/docker/library/busybox:latest"
+    command   = ["sleep", "3600"]
+    essential = true
+    mountPoints = [{
+      sourceVolume  = "data"
+      containerPath = "/data"
+    }]
+  }])
+
+  volume {
+    name                = "data"
+    configure_at_launch = true
+  }
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.ebs.arn
+  desired_count   = 1
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    security_groups  = [aws_security_group.test[0].id]
+    subnets          = aws_subnet.test[*].id
+    assign_public_ip = true
+  }
+
+  volume_configuration {
+    name = "data"
+
+    managed_ebs_volume {
+      role_arn   = aws_iam_role.test.arn
+      size_in_gb = %[2]d
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, sizeInGB))
+}
+
 func testAccServiceConfig_interchangeablePlacementStrategy(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -4303,6 +4440,196 @@ resource "aws_ecs_service" "test" {
 `, rName)
 }
 
+// testAccCheckServiceConnectTLSAndTimeout checks the Service Connect settings of the service's primary deployment,
+// as DescribeServices doesn't return them on the service itself.
+func testAccCheckServiceConnectTLSAndTimeout(service *ecs.Service, idleTimeout, perRequestTimeout int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, deployment := range service.Deployments {
+			if aws.StringValue(deployment.Status) != "PRIMARY" || deployment.ServiceConnectConfiguration == nil {
+				continue
+			}
+
+			for _, v := range deployment.ServiceConnectConfiguration.Services {
+				if v.Tls == nil || v.Tls.IssuerCertificateAuthority == nil {
+					return fmt.Errorf("ECS Service (%s) Service Connect service (%s) has no TLS configuration", aws.StringValue(service.ServiceName), aws.StringValue(v.PortName))
+				}
+
+				if v.Timeout == nil {
+					return fmt.Errorf("ECS Service (%s) Service Connect service (%s) has no timeout configuration", aws.StringValue(service.ServiceName), aws.StringValue(v.PortName))
+				}
+
+				if got := aws.Int64Value(v.Timeout.IdleTimeoutSeconds); got != idleTimeout {
+					return fmt.Errorf("ECS Service (%s) Service Connect idle timeout is %d, expected %d", aws.StringValue(service.ServiceName), got, idleTimeout)
+				}
+
+				if got := aws.Int64Value(v.Timeout.PerRequestTimeoutSeconds); got != perRequestTimeout {
+					return fmt.Errorf("ECS Service (%s) Service Connect per-request timeout is %d, expected %d", aws.StringValue(service.ServiceName), got, perRequestTimeout)
+				}
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("ECS Service (%s) has no primary deployment with Service Connect configuration", aws.StringValue(service.ServiceName))
+	}
+}
+
+func testAccServiceConfig_serviceConnectTLSAndTimeout(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                            = "ROOT"
+
+  certificate_authority_configuration {
+    key_algorithm     = "EC_prime256v1"
+    signing_algorithm = "SHA256WITHECDSA"
+
+    subject {
+      common_name = "%[1]s.example.com"
+    }
+  }
+
+  tags = {
+    AmazonECSManaged = "true"
+  }
+}
+
+resource "aws_acmpca_certificate" "test" {
+  certificate_authority_arn   = aws_acmpca_certificate_authority.test.arn
+  certificate_signing_request = aws_acmpca_certificate_authority.test.certificate_signing_request
+  signing_algorithm           = "SHA256WITHECDSA"
+
+  template_arn = "arn:${data.aws_partition.current.partition}:acm-pca:::template/RootCACertificate/V1"
+
+  validity {
+    type  = "YEARS"
+    value = 1
+  }
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+
+  certificate       = aws_acmpca_certificate.test.certificate
+  certificate_chain = aws_acmpca_certificate.test.certificate_chain
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Action    = "sts:AssumeRole"
+      Principal = { Service = "ecs.amazonaws.com" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonECSInfrastructureRolePolicyForServiceConnectTransportLayerSecurity"
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  ingress {
+    protocol    = "6"
+    from_port   = 80
+    to_port     = 8000
+    cidr_blocks = [aws_vpc.test.cidr_block]
+  }
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_service_discovery_http_namespace" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family       = %[1]q
+  network_mode = "awsvpc"
+
+  container_definitions = jsonencode([{
+    name      = "test-nginx"
+    image     = "nginx"
+    cpu       = 10
+    memory    = 512
+    essential = true
+    portMappings = [{
+      name          = "nginx-http"
+      containerPort = 8080
+      protocol      = "tcp"
+      appProtocol   = "http"
+    }]
+  }])
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 1
+
+  network_configuration {
+    subnets          = aws_subnet.test[*].id
+    security_groups  = [aws_security_group.test.id]
+    assign_public_ip = false
+  }
+
+  service_connect_configuration {
+    enabled   = true
+    namespace = aws_service_discovery_http_namespace.test.arn
+
+    service {
+      client_alias {
+        port = 8080
+      }
+
+      port_name = "nginx-http"
+
+      timeout {
+        idle_timeout_seconds        = 300
+        per_request_timeout_seconds = 60
+      }
+
+      tls {
+        issuer_cert_authority {
+          aws_pca_authority_arn = aws_acmpca_certificate_authority.test.arn
+        }
+
+        role_arn = aws_iam_role.test.arn
+      }
+    }
+  }
+
+  depends_on = [
+    aws_acmpca_certificate_authority_certificate.test,
+    aws_iam_role_policy_attachment.test,
+  ]
+}
+`, rName))
+}
+
 func testAccServiceConfig_serviceConnectIngressPortOverride(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
 resource "aws_security_group" "test" {
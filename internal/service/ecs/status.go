@@ -5,9 +5,11 @@ package ecs
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -20,11 +22,25 @@ const (
 	serviceStatusPending = "tfPENDING"
 	serviceStatusStable  = "tfSTABLE"
 
+	serviceDeploymentStatusPrimary = "PRIMARY"
+
 	taskSetStatusActive   = "ACTIVE"
 	taskSetStatusDraining = "DRAINING"
 	taskSetStatusPrimary  = "PRIMARY"
 )
 
+// Task lifecycle states, see https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task-lifecycle.html.
+const (
+	taskStatusActivating     = "ACTIVATING"
+	taskStatusDeactivating   = "DEACTIVATING"
+	taskStatusDeprovisioning = "DEPROVISIONING"
+	taskStatusPending        = "PENDING"
+	taskStatusProvisioning   = "PROVISIONING"
+	taskStatusRunning        = "RUNNING"
+	taskStatusStopped        = "STOPPED"
+	taskStatusStopping       = "STOPPING"
+)
+
 func statusCapacityProvider(ctx context.Context, conn *ecs.ECS, arn string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindCapacityProviderByARN(ctx, conn, arn)
@@ -72,6 +88,9 @@ func statusServiceNoTags(ctx context.Context, conn *ecs.ECS, id, cluster string)
 }
 
 func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster string) retry.StateRefreshFunc {
+	// The deployment being waited on is the one that is PRIMARY when waiting starts.
+	var deploymentID string
+
 	return func() (interface{}, string, error) {
 		serviceRaw, status, err := statusServiceNoTags(ctx, conn, id, cluster)()
 		if err != nil {
@@ -84,16 +103,76 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster
 
 		service := serviceRaw.(*ecs.Service)
 
+		if deploymentID == "" {
+			if deployment := findPrimaryServiceDeployment(service.Deployments); deployment != nil {
+				deploymentID = aws.StringValue(deployment.Id)
+			}
+		}
+
+		if deployment := findServiceDeploymentByID(service.Deployments, deploymentID); deployment != nil {
+			tflog.Info(ctx, "Waiting for ECS Service deployment", map[string]interface{}{
+				"service":       aws.StringValue(service.ServiceName),
+				"deployment_id": deploymentID,
+				"rollout_state": aws.StringValue(deployment.RolloutState),
+				"desired_count": aws.Int64Value(deployment.DesiredCount),
+				"running_count": aws.Int64Value(deployment.RunningCount),
+				"pending_count": aws.Int64Value(deployment.PendingCount),
+				"failed_tasks":  aws.Int64Value(deployment.FailedTasks),
+			})
+		}
+
+		status, err = serviceDeploymentStatus(service, deploymentID)
+
+		return service, status, err
+	}
+}
+
+// serviceDeploymentStatus returns whether the specified deployment of an ECS Service has reached a steady state.
+// Services using the ECS deployment controller are tracked by the deployment's rollout state, so that failed
+// deployments, including those rolled back by the deployment circuit breaker, are returned as errors.
+// For other deployment controllers the service is stable once it has a single deployment with all desired tasks running.
+func serviceDeploymentStatus(service *ecs.Service, deploymentID string) (string, error) {
+	deployment := findServiceDeploymentByID(service.Deployments, deploymentID)
+
+	if deployment == nil && deploymentID != "" {
+		// A deployment that disappears while being tracked has been replaced, e.g. by a circuit breaker rollback.
+		if v := findPrimaryServiceDeployment(service.Deployments); v != nil && v.RolloutState != nil {
+			return "", fmt.Errorf("deployment (%s) was replaced by deployment (%s)", deploymentID, aws.StringValue(v.Id))
+		}
+	}
+
+	if deployment == nil || deployment.RolloutState == nil {
 		if d, dc, rc := len(service.Deployments),
 			aws.Int64Value(service.DesiredCount),
 			aws.Int64Value(service.RunningCount); d == 1 && dc == rc {
-			status = serviceStatusStable
-		} else {
-			status = serviceStatusPending
+			return serviceStatusStable, nil
 		}
 
-		return service, status, nil
+		return serviceStatusPending, nil
 	}
+
+	primary := aws.StringValue(deployment.Status) == serviceDeploymentStatusPrimary
+
+	switch rolloutState := aws.StringValue(deployment.RolloutState); rolloutState {
+	case ecs.DeploymentRolloutStateCompleted:
+		if primary {
+			return serviceStatusStable, nil
+		}
+	case ecs.DeploymentRolloutStateFailed:
+		if !primary {
+			return "", fmt.Errorf("deployment (%s) failed and was rolled back by the deployment circuit breaker: %s", deploymentID, aws.StringValue(deployment.RolloutStateReason))
+		}
+
+		return "", fmt.Errorf("deployment (%s) failed: %s", deploymentID, aws.StringValue(deployment.RolloutStateReason))
+	}
+
+	if !primary {
+		if v := findPrimaryServiceDeployment(service.Deployments); v != nil {
+			return "", fmt.Errorf("deployment (%s) was replaced by deployment (%s)", deploymentID, aws.StringValue(v.Id))
+		}
+	}
+
+	return serviceStatusPending, nil
 }
 
 func stabilityStatusTaskSet(ctx context.Context, conn *ecs.ECS, taskSetID, service, cluster string) retry.StateRefreshFunc {
@@ -139,3 +218,19 @@ func statusTaskSet(ctx context.Context, conn *ecs.ECS, taskSetID, service, clust
 		return output.TaskSets[0], aws.StringValue(output.TaskSets[0].Status), nil
 	}
 }
+
+func statusTask(ctx context.Context, conn *ecs.ECS, taskARN, cluster string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTaskByTwoPartKey(ctx, conn, taskARN, cluster)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.LastStatus), nil
+	}
+}
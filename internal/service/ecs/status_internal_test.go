@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestServiceDeploymentStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		Service        *ecs.Service
+		DeploymentID   string
+		ExpectedStatus string
+		ExpectError    bool
+	}{
+		{
+			Name: "external controller pending",
+			Service: &ecs.Service{
+				DesiredCount: aws.Int64(2),
+				RunningCount: aws.Int64(1),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("PRIMARY")},
+				},
+			},
+			DeploymentID:   "ecs-svc/1",
+			ExpectedStatus: serviceStatusPending,
+		},
+		{
+			Name: "external controller stable",
+			Service: &ecs.Service{
+				DesiredCount: aws.Int64(2),
+				RunningCount: aws.Int64(2),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("PRIMARY")},
+				},
+			},
+			DeploymentID:   "ecs-svc/1",
+			ExpectedStatus: serviceStatusStable,
+		},
+		{
+			Name: "in progress",
+			Service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress)},
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("ACTIVE"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted)},
+				},
+			},
+			DeploymentID:   "ecs-svc/2",
+			ExpectedStatus: serviceStatusPending,
+		},
+		{
+			Name: "completed",
+			Service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted)},
+				},
+			},
+			DeploymentID:   "ecs-svc/2",
+			ExpectedStatus: serviceStatusStable,
+		},
+		{
+			Name: "failed",
+			Service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateFailed)},
+				},
+			},
+			DeploymentID: "ecs-svc/2",
+			ExpectError:  true,
+		},
+		{
+			Name: "rolled back",
+			Service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/3"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress)},
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("ACTIVE"), RolloutState: aws.String(ecs.DeploymentRolloutStateFailed)},
+				},
+			},
+			DeploymentID: "ecs-svc/2",
+			ExpectError:  true,
+		},
+		{
+			Name: "rollback completed",
+			Service: &ecs.Service{
+				DesiredCount: aws.Int64(1),
+				RunningCount: aws.Int64(1),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/3"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted)},
+				},
+			},
+			DeploymentID: "ecs-svc/2",
+			ExpectError:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			status, err := serviceDeploymentStatus(testCase.Service, testCase.DeploymentID)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatalf("expected error, got status %q", status)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if status != testCase.ExpectedStatus {
+				t.Errorf("got status %q, expected %q", status, testCase.ExpectedStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,523 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ecs_task", name="Task")
+func ResourceTask() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTaskCreate,
+		ReadWithoutTimeout:   resourceTaskRead,
+		DeleteWithoutTimeout: resourceTaskDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(taskRunTimeout),
+			Delete: schema.DefaultTimeout(taskStopTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_provider_strategy": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"launch_type"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 100000),
+						},
+						"capacity_provider": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+			"cluster": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"container": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exit_code": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"enable_execute_command": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"group": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"last_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"launch_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"capacity_provider_strategy"},
+				ValidateFunc:  validation.StringInSlice(ecs.LaunchType_Values(), false),
+			},
+			"network_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assign_public_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"overrides": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_overrides": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"command": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"environment": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"execution_role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"task_role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"platform_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"propagate_tags": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ecs.PropagateTags_Values(), false),
+			},
+			"started_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"stop_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stopped_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaForceNew(),
+			"task_definition": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Changing any value runs the task again, as for null_resource.
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"volume_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_ebs_volume": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: managedEBSVolumeSchema(true),
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+
+	cluster := d.Get("cluster").(string)
+	taskDefinition := d.Get("task_definition").(string)
+	input := &ecs.RunTaskInput{
+		Cluster:        aws.String(cluster),
+		Count:          aws.Int64(1),
+		TaskDefinition: aws.String(taskDefinition),
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})))
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("capacity_provider_strategy"); ok {
+		input.CapacityProviderStrategy = expandCapacityProviderStrategy(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("enable_execute_command"); ok {
+		input.EnableExecuteCommand = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("group"); ok {
+		input.Group = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("launch_type"); ok {
+		input.LaunchType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("network_configuration"); ok {
+		input.NetworkConfiguration = expandNetworkConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("overrides"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Overrides = expandTaskResourceOverrides(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("platform_version"); ok {
+		input.PlatformVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("propagate_tags"); ok {
+		input.PropagateTags = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("started_by"); ok {
+		input.StartedBy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("volume_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.VolumeConfigurations = expandTaskVolumeConfigurations(v.([]interface{}))
+	}
+
+	output, err := conn.RunTaskWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "running ECS Task (%s): %s", taskDefinition, err)
+	}
+
+	if len(output.Failures) > 0 {
+		var reasons []string
+		for _, v := range output.Failures {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", aws.StringValue(v.Reason), aws.StringValue(v.Detail)))
+		}
+
+		return sdkdiag.AppendErrorf(diags, "running ECS Task (%s): %s", taskDefinition, strings.Join(reasons, "; "))
+	}
+
+	if len(output.Tasks) == 0 || output.Tasks[0] == nil {
+		return sdkdiag.AppendErrorf(diags, "running ECS Task (%s): %s", taskDefinition, tfresource.NewEmptyResultError(input))
+	}
+
+	d.SetId(aws.StringValue(output.Tasks[0].TaskArn))
+
+	task, err := waitTaskStopped(ctx, conn, d.Id(), cluster, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ECS Task (%s) stop: %s", d.Id(), err)
+	}
+
+	// Record the outcome before reporting any failure so that it is visible in state.
+	setTaskOutcome(d, task)
+
+	if err := taskExitError(task); err != nil {
+		return sdkdiag.AppendErrorf(diags, "ECS Task (%s) failed: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTaskRead(ctx, d, meta)...)
+}
+
+func resourceTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+
+	task, err := FindTaskByTwoPartKey(ctx, conn, d.Id(), d.Get("cluster").(string))
+
+	// ECS only keeps stopped tasks for a short time. A task that has aged out is not removed from
+	// state, as that would run it again.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[DEBUG] ECS Task (%s) no longer described, keeping its recorded outcome", d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Task (%s): %s", d.Id(), err)
+	}
+
+	setTaskOutcome(d, task)
+
+	return diags
+}
+
+func resourceTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+
+	cluster := d.Get("cluster").(string)
+	task, err := FindTaskByTwoPartKey(ctx, conn, d.Id(), cluster)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Task (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(task.LastStatus) == taskStatusStopped {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Stopping ECS Task: %s", d.Id())
+	_, err = conn.StopTaskWithContext(ctx, &ecs.StopTaskInput{
+		Cluster: aws.String(cluster),
+		Reason:  aws.String("Stopped by Terraform"),
+		Task:    aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "stopping ECS Task (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitTaskStopped(ctx, conn, d.Id(), cluster, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ECS Task (%s) stop: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func setTaskOutcome(d *schema.ResourceData, task *ecs.Task) {
+	d.Set("arn", task.TaskArn)
+	d.Set("container", flattenTaskContainers(task.Containers))
+	d.Set("last_status", task.LastStatus)
+	d.Set("stop_code", task.StopCode)
+	d.Set("stopped_reason", task.StoppedReason)
+}
+
+// taskExitError returns an error if any container in a stopped task exited with a non-zero code or never ran.
+func taskExitError(task *ecs.Task) error {
+	var failures []string
+
+	for _, v := range task.Containers {
+		if v == nil {
+			continue
+		}
+
+		name := aws.StringValue(v.Name)
+
+		switch {
+		case v.ExitCode == nil:
+			failure := fmt.Sprintf("container %s did not run", name)
+			if reason := aws.StringValue(v.Reason); reason != "" {
+				failure += ": " + reason
+			}
+			failures = append(failures, failure)
+		case aws.Int64Value(v.ExitCode) != 0:
+			failure := fmt.Sprintf("container %s exited with code %d", name, aws.Int64Value(v.ExitCode))
+			if reason := aws.StringValue(v.Reason); reason != "" {
+				failure += ": " + reason
+			}
+			failures = append(failures, failure)
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	if reason := aws.StringValue(task.StoppedReason); reason != "" {
+		return fmt.Errorf("%s (stopped reason: %s)", strings.Join(failures, "; "), reason)
+	}
+
+	return fmt.Errorf("%s", strings.Join(failures, "; "))
+}
+
+func expandTaskResourceOverrides(tfMap map[string]interface{}) *ecs.TaskOverride {
+	apiObject := &ecs.TaskOverride{}
+
+	if v, ok := tfMap["container_overrides"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			containerOverride := &ecs.ContainerOverride{
+				Name: aws.String(tfMap["name"].(string)),
+			}
+
+			if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+				containerOverride.Command = flex.ExpandStringList(v)
+			}
+
+			if v, ok := tfMap["environment"].(*schema.Set); ok && v.Len() > 0 {
+				containerOverride.Environment = expandTaskEnvironment(v)
+			}
+
+			apiObject.ContainerOverrides = append(apiObject.ContainerOverrides, containerOverride)
+		}
+	}
+
+	if v, ok := tfMap["execution_role_arn"].(string); ok && v != "" {
+		apiObject.ExecutionRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["task_role_arn"].(string); ok && v != "" {
+		apiObject.TaskRoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenTaskContainers(apiObjects []*ecs.Container) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"exit_code": aws.Int64Value(apiObject.ExitCode),
+			"name":      aws.StringValue(apiObject.Name),
+			"reason":    aws.StringValue(apiObject.Reason),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,697 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// Defaults applied by ECS to container health checks when they are not specified.
+const (
+	containerHealthCheckDefaultInterval = 30
+	containerHealthCheckDefaultRetries  = 3
+	containerHealthCheckDefaultTimeout  = 5
+)
+
+// taskDefinitionContainerSchema returns the schema for the typed alternative to `container_definitions`.
+// Attribute names follow the aws_ecs_container_definition data source where the two overlap.
+func taskDefinitionContainerSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ExactlyOneOf: []string{"container", "container_definitions"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"command": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"cpu": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+				"depends_on": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"condition": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ContainerCondition_Values(), false),
+							},
+							"container_name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"disable_networking": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"docker_labels": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"entry_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"environment": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"essential": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  true,
+				},
+				"health_check": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								MinItems: 1,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"interval": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      containerHealthCheckDefaultInterval,
+								ValidateFunc: validation.IntBetween(5, 300),
+							},
+							"retries": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      containerHealthCheckDefaultRetries,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"start_period": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"timeout": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      containerHealthCheckDefaultTimeout,
+								ValidateFunc: validation.IntBetween(2, 120),
+							},
+						},
+					},
+				},
+				"image": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"log_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_driver": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+							},
+							"options": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"secret_options": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"memory": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+				"memory_reservation": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"port_mapping": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"app_protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ApplicationProtocol_Values(), false),
+							},
+							"container_port": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumber,
+							},
+							// In awsvpc network mode ECS sets the host port to the container port,
+							// and in bridge mode an omitted host port is dynamically assigned.
+							"host_port": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumberOrZero,
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      ecs.TransportProtocolTcp,
+								ValidateFunc: validation.StringInSlice(ecs.TransportProtocol_Values(), false),
+							},
+						},
+					},
+				},
+				"secrets": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"ulimit": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"hard_limit": {
+								Type:     schema.TypeInt,
+								Required: true,
+								ForceNew: true,
+							},
+							"name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.UlimitName_Values(), false),
+							},
+							"soft_limit": {
+								Type:     schema.TypeInt,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"user": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"working_directory": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func expandTaskDefinitionContainers(tfList []interface{}) []*ecs.ContainerDefinition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ecs.ContainerDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandTaskDefinitionContainer(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandTaskDefinitionContainer(tfMap map[string]interface{}) *ecs.ContainerDefinition {
+	apiObject := &ecs.ContainerDefinition{
+		Essential: aws.Bool(tfMap["essential"].(bool)),
+		Image:     aws.String(tfMap["image"].(string)),
+		Name:      aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Command = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+		apiObject.Cpu = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DependsOn = expandContainerDependencies(v)
+	}
+
+	if v, ok := tfMap["disable_networking"].(bool); ok && v {
+		apiObject.DisableNetworking = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["docker_labels"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.DockerLabels = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+		apiObject.EntryPoint = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["environment"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Environment = expandContainerKeyValuePairs(v)
+	}
+
+	if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HealthCheck = expandContainerHealthCheck(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LogConfiguration = expandContainerLogConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["memory"].(int); ok && v != 0 {
+		apiObject.Memory = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+		apiObject.MemoryReservation = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["port_mapping"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PortMappings = expandContainerPortMappings(v)
+	}
+
+	if v, ok := tfMap["secrets"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Secrets = expandContainerSecrets(v)
+	}
+
+	if v, ok := tfMap["ulimit"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Ulimits = expandContainerUlimits(v.List())
+	}
+
+	if v, ok := tfMap["user"].(string); ok && v != "" {
+		apiObject.User = aws.String(v)
+	}
+
+	if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+		apiObject.WorkingDirectory = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandContainerDependencies(tfList []interface{}) []*ecs.ContainerDependency {
+	var apiObjects []*ecs.ContainerDependency
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.ContainerDependency{
+			Condition:     aws.String(tfMap["condition"].(string)),
+			ContainerName: aws.String(tfMap["container_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+// expandContainerKeyValuePairs returns the pairs sorted by name, the order in which they are flattened.
+func expandContainerKeyValuePairs(tfMap map[string]interface{}) []*ecs.KeyValuePair {
+	var apiObjects []*ecs.KeyValuePair
+
+	for _, k := range sortedKeys(tfMap) {
+		apiObjects = append(apiObjects, &ecs.KeyValuePair{
+			Name:  aws.String(k),
+			Value: aws.String(tfMap[k].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerSecrets(tfMap map[string]interface{}) []*ecs.Secret {
+	var apiObjects []*ecs.Secret
+
+	for _, k := range sortedKeys(tfMap) {
+		apiObjects = append(apiObjects, &ecs.Secret{
+			Name:      aws.String(k),
+			ValueFrom: aws.String(tfMap[k].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerHealthCheck(tfMap map[string]interface{}) *ecs.HealthCheck {
+	apiObject := &ecs.HealthCheck{
+		Command:  flex.ExpandStringList(tfMap["command"].([]interface{})),
+		Interval: aws.Int64(int64(tfMap["interval"].(int))),
+		Retries:  aws.Int64(int64(tfMap["retries"].(int))),
+		Timeout:  aws.Int64(int64(tfMap["timeout"].(int))),
+	}
+
+	if v, ok := tfMap["start_period"].(int); ok && v != 0 {
+		apiObject.StartPeriod = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandContainerLogConfiguration(tfMap map[string]interface{}) *ecs.LogConfiguration {
+	apiObject := &ecs.LogConfiguration{
+		LogDriver: aws.String(tfMap["log_driver"].(string)),
+	}
+
+	if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Options = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["secret_options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.SecretOptions = expandContainerSecrets(v)
+	}
+
+	return apiObject
+}
+
+func expandContainerPortMappings(tfList []interface{}) []*ecs.PortMapping {
+	var apiObjects []*ecs.PortMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.PortMapping{
+			ContainerPort: aws.Int64(int64(tfMap["container_port"].(int))),
+			Protocol:      aws.String(tfMap["protocol"].(string)),
+		}
+
+		if v, ok := tfMap["app_protocol"].(string); ok && v != "" {
+			apiObject.AppProtocol = aws.String(v)
+		}
+
+		if v, ok := tfMap["host_port"].(int); ok && v != 0 {
+			apiObject.HostPort = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerUlimits(tfList []interface{}) []*ecs.Ulimit {
+	var apiObjects []*ecs.Ulimit
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.Ulimit{
+			HardLimit: aws.Int64(int64(tfMap["hard_limit"].(int))),
+			Name:      aws.String(tfMap["name"].(string)),
+			SoftLimit: aws.Int64(int64(tfMap["soft_limit"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+// flattenTaskDefinitionContainers flattens container definitions returned by ECS, normalizing the
+// values ECS fills in for omitted settings to the schema's defaults.
+func flattenTaskDefinitionContainers(apiObjects []*ecs.ContainerDefinition) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenTaskDefinitionContainer(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenTaskDefinitionContainer(apiObject *ecs.ContainerDefinition) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"command":            aws.StringValueSlice(apiObject.Command),
+		"cpu":                aws.Int64Value(apiObject.Cpu),
+		"disable_networking": aws.BoolValue(apiObject.DisableNetworking),
+		"docker_labels":      aws.StringValueMap(apiObject.DockerLabels),
+		"entry_point":        aws.StringValueSlice(apiObject.EntryPoint),
+		"essential":          apiObject.Essential == nil || aws.BoolValue(apiObject.Essential),
+		"image":              aws.StringValue(apiObject.Image),
+		"memory":             aws.Int64Value(apiObject.Memory),
+		"memory_reservation": aws.Int64Value(apiObject.MemoryReservation),
+		"name":               aws.StringValue(apiObject.Name),
+		"user":               aws.StringValue(apiObject.User),
+		"working_directory":  aws.StringValue(apiObject.WorkingDirectory),
+	}
+
+	if v := apiObject.DependsOn; len(v) > 0 {
+		tfMap["depends_on"] = flattenContainerDependencies(v)
+	}
+
+	if v := apiObject.Environment; len(v) > 0 {
+		tfMap["environment"] = flattenContainerKeyValuePairs(v)
+	}
+
+	if v := apiObject.HealthCheck; v != nil {
+		tfMap["health_check"] = []interface{}{flattenContainerHealthCheck(v)}
+	}
+
+	if v := apiObject.LogConfiguration; v != nil {
+		tfMap["log_configuration"] = []interface{}{flattenContainerLogConfiguration(v)}
+	}
+
+	if v := apiObject.PortMappings; len(v) > 0 {
+		tfMap["port_mapping"] = flattenContainerPortMappings(v)
+	}
+
+	if v := apiObject.Secrets; len(v) > 0 {
+		tfMap["secrets"] = flattenContainerSecrets(v)
+	}
+
+	if v := apiObject.Ulimits; len(v) > 0 {
+		tfMap["ulimit"] = flattenContainerUlimits(v)
+	}
+
+	return tfMap
+}
+
+func flattenContainerDependencies(apiObjects []*ecs.ContainerDependency) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"condition":      aws.StringValue(apiObject.Condition),
+			"container_name": aws.StringValue(apiObject.ContainerName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerKeyValuePairs(apiObjects []*ecs.KeyValuePair) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap[aws.StringValue(apiObject.Name)] = aws.StringValue(apiObject.Value)
+	}
+
+	return tfMap
+}
+
+func flattenContainerSecrets(apiObjects []*ecs.Secret) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap[aws.StringValue(apiObject.Name)] = aws.StringValue(apiObject.ValueFrom)
+	}
+
+	return tfMap
+}
+
+func flattenContainerHealthCheck(apiObject *ecs.HealthCheck) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"command":      aws.StringValueSlice(apiObject.Command),
+		"interval":     containerHealthCheckDefaultInterval,
+		"retries":      containerHealthCheckDefaultRetries,
+		"start_period": aws.Int64Value(apiObject.StartPeriod),
+		"timeout":      containerHealthCheckDefaultTimeout,
+	}
+
+	if v := apiObject.Interval; v != nil {
+		tfMap["interval"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Retries; v != nil {
+		tfMap["retries"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Timeout; v != nil {
+		tfMap["timeout"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenContainerLogConfiguration(apiObject *ecs.LogConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"log_driver": aws.StringValue(apiObject.LogDriver),
+		"options":    aws.StringValueMap(apiObject.Options),
+	}
+
+	if v := apiObject.SecretOptions; len(v) > 0 {
+		tfMap["secret_options"] = flattenContainerSecrets(v)
+	}
+
+	return tfMap
+}
+
+func flattenContainerPortMappings(apiObjects []*ecs.PortMapping) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"app_protocol":   aws.StringValue(apiObject.AppProtocol),
+			"container_port": aws.Int64Value(apiObject.ContainerPort),
+			"host_port":      aws.Int64Value(apiObject.HostPort),
+			"name":           aws.StringValue(apiObject.Name),
+			"protocol":       ecs.TransportProtocolTcp,
+		}
+
+		if v := apiObject.Protocol; v != nil {
+			tfMap["protocol"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenContainerUlimits(apiObjects []*ecs.Ulimit) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"hard_limit": aws.Int64Value(apiObject.HardLimit),
+			"name":       aws.StringValue(apiObject.Name),
+			"soft_limit": aws.Int64Value(apiObject.SoftLimit),
+		})
+	}
+
+	return tfList
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
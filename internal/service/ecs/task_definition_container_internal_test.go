@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFlattenTaskDefinitionContainerNormalizesDefaults(t *testing.T) {
+	t.Parallel()
+
+	apiObject := &ecs.ContainerDefinition{
+		Cpu:   aws.Int64(0),
+		Image: aws.String("busybox"),
+		Name:  aws.String("app"),
+		Environment: []*ecs.KeyValuePair{
+			{Name: aws.String("B"), Value: aws.String("2")},
+			{Name: aws.String("A"), Value: aws.String("1")},
+		},
+		HealthCheck: &ecs.HealthCheck{
+			Command: aws.StringSlice([]string{"CMD", "true"}),
+		},
+		PortMappings: []*ecs.PortMapping{
+			{ContainerPort: aws.Int64(80)},
+		},
+	}
+
+	tfMap := flattenTaskDefinitionContainer(apiObject)
+
+	if got, want := tfMap["essential"], true; got != want {
+		t.Errorf("essential = %v, want %v", got, want)
+	}
+
+	if got, want := tfMap["environment"], map[string]interface{}{"A": "1", "B": "2"}; !cmp.Equal(got, want) {
+		t.Errorf("environment = %v, want %v", got, want)
+	}
+
+	wantHealthCheck := map[string]interface{}{
+		"command":      []string{"CMD", "true"},
+		"interval":     containerHealthCheckDefaultInterval,
+		"retries":      containerHealthCheckDefaultRetries,
+		"start_period": int64(0),
+		"timeout":      containerHealthCheckDefaultTimeout,
+	}
+	if got := tfMap["health_check"].([]interface{})[0]; !cmp.Equal(got, wantHealthCheck) {
+		t.Errorf("health_check = %v, want %v", got, wantHealthCheck)
+	}
+
+	if got, want := tfMap["port_mapping"].([]interface{})[0].(map[string]interface{})["protocol"], ecs.TransportProtocolTcp; got != want {
+		t.Errorf("port_mapping protocol = %v, want %v", got, want)
+	}
+}
+
+func TestExpandTaskDefinitionContainerRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tfMap := map[string]interface{}{
+		"cpu":         0,
+		"environment": map[string]interface{}{"B": "2", "A": "1"},
+		"essential":   true,
+		"image":       "busybox",
+		"name":        "app",
+		"port_mapping": []interface{}{
+			map[string]interface{}{
+				"container_port": 80,
+				"host_port":      0,
+				"protocol":       ecs.TransportProtocolTcp,
+			},
+		},
+		"secrets": map[string]interface{}{"TOKEN": "token-parameter"},
+	}
+
+	apiObject := expandTaskDefinitionContainer(tfMap)
+
+	if apiObject.Cpu != nil {
+		t.Errorf("Cpu = %d, want nil", aws.Int64Value(apiObject.Cpu))
+	}
+
+	if got, want := aws.StringValue(apiObject.Environment[0].Name), "A"; got != want {
+		t.Errorf("first environment variable = %s, want %s", got, want)
+	}
+
+	if apiObject.PortMappings[0].HostPort != nil {
+		t.Errorf("HostPort = %d, want nil", aws.Int64Value(apiObject.PortMappings[0].HostPort))
+	}
+
+	flattened := flattenTaskDefinitionContainer(apiObject)
+
+	if got, want := flattened["environment"], tfMap["environment"]; !cmp.Equal(got, want) {
+		t.Errorf("environment = %v, want %v", got, want)
+	}
+
+	if got, want := flattened["secrets"], tfMap["secrets"]; !cmp.Equal(got, want) {
+		t.Errorf("secrets = %v, want %v", got, want)
+	}
+}
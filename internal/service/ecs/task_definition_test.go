@@ -1090,6 +1090,121 @@ func TestAccECSTaskDefinition_invalidContainerDefinition(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_configureAtLaunch(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_configureAtLaunch(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "volume.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "volume.*", map[string]string{
+						"name":                "data",
+						"configure_at_launch": "true",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_container(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_container(rName, "info"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.name", "app"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.essential", "true"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.environment.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.environment.LOG_LEVEL", "info"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.port_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.port_mapping.0.container_port", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.port_mapping.0.host_port", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.port_mapping.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.health_check.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.health_check.0.interval", "30"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.health_check.0.retries", "3"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.health_check.0.timeout", "5"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.log_configuration.0.log_driver", "awslogs"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.depends_on.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.depends_on.0.container_name", "init"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.depends_on.0.condition", "SUCCESS"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "container.0.ulimit.*", map[string]string{
+						"name":       "nofile",
+						"soft_limit": "1024",
+						"hard_limit": "4096",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "container.1.name", "init"),
+					resource.TestCheckResourceAttr(resourceName, "container.1.essential", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "container_definitions"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_destroy"},
+			},
+			{
+				Config: testAccTaskDefinitionConfig_container(rName, "debug"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container.0.environment.LOG_LEVEL", "debug"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_containerAndContainerDefinitions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskDefinitionConfig_containerAndContainerDefinitions(rName),
+				ExpectError: regexp.MustCompile(`only one of .container,container_definitions. can be specified`),
+			},
+		},
+	})
+}
+
 func testAccTaskDefinitionConfig_proxyConfiguration(rName string, containerName string, proxyType string,
 	ignoredUid string, ignoredGid string, appPorts string, proxyIngressPort string, proxyEgressPort string,
 	egressIgnoredPorts string, egressIgnoredIPs string) string {
@@ -2735,3 +2850,118 @@ TASK_DEFINITION
 }
 `, rName)
 }
+
+func testAccTaskDefinitionConfig_container(rName, logLevel string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container {
+    name   = "app"
+    image  = "busybox"
+    memory = 128
+
+    command = ["httpd", "-f", "-p", "8080"]
+
+    port_mapping {
+      container_port = 8080
+    }
+
+    environment = {
+      LOG_LEVEL = %[2]q
+      NAME      = %[1]q
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "wget -q -O /dev/null http://localhost:8080/ || exit 1"]
+    }
+
+    log_configuration {
+      log_driver = "awslogs"
+      options = {
+        awslogs-group         = aws_cloudwatch_log_group.test.name
+        awslogs-region        = data.aws_region.current.name
+        awslogs-stream-prefix = "app"
+      }
+    }
+
+    depends_on {
+      container_name = "init"
+      condition      = "SUCCESS"
+    }
+
+    ulimit {
+      name       = "nofile"
+      soft_limit = 1024
+      hard_limit = 4096
+    }
+  }
+
+  container {
+    name      = "init"
+    image     = "busybox"
+    essential = false
+    command   = ["true"]
+  }
+}
+`, rName, logLevel)
+}
+
+func testAccTaskDefinitionConfig_containerAndContainerDefinitions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container {
+    name   = "app"
+    image  = "busybox"
+    memory = 128
+  }
+
+  container_definitions = jsonencode([
+    {
+      name   = "app"
+      image  = "busybox"
+      memory = 128
+    }
+  ])
+}
+`, rName)
+}
+
+func testAccTaskDefinitionConfig_configureAtLaunch(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = jsonencode([{
+    name      = "app"
+    image     = "busybox"
+    essential = true
+    mountPoints = [{
+      sourceVolume  = "data"
+      containerPath = "/data"
+    }]
+  }])
+
+  volume {
+    name                = "data"
+    configure_at_launch = true
+  }
+}
+`, rName)
+}
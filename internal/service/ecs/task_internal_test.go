@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestTaskExitError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		task      *ecs.Task
+		wantError string
+	}{
+		"all zero": {
+			task: &ecs.Task{
+				Containers: []*ecs.Container{
+					{Name: aws.String("app"), ExitCode: aws.Int64(0)},
+					{Name: aws.String("sidecar"), ExitCode: aws.Int64(0)},
+				},
+			},
+		},
+		"non-zero": {
+			task: &ecs.Task{
+				Containers: []*ecs.Container{
+					{Name: aws.String("app"), ExitCode: aws.Int64(2)},
+				},
+				StoppedReason: aws.String("Essential container in task exited"),
+			},
+			wantError: "container app exited with code 2 (stopped reason: Essential container in task exited)",
+		},
+		"never ran": {
+			task: &ecs.Task{
+				Containers: []*ecs.Container{
+					{Name: aws.String("app"), Reason: aws.String("CannotPullContainerError: not found")},
+				},
+			},
+			wantError: "container app did not run: CannotPullContainerError: not found",
+		},
+		"one of several": {
+			task: &ecs.Task{
+				Containers: []*ecs.Container{
+					{Name: aws.String("app"), ExitCode: aws.Int64(0)},
+					{Name: aws.String("migrate"), ExitCode: aws.Int64(1), Reason: aws.String("OutOfMemoryError")},
+				},
+			},
+			wantError: "container migrate exited with code 1: OutOfMemoryError",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := taskExitError(testCase.task)
+
+			if testCase.wantError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.wantError)
+			}
+
+			if got := err.Error(); got != testCase.wantError {
+				t.Errorf("error = %q, want %q", got, testCase.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// managedEBSVolumeSchema returns the schema for an Amazon EBS volume that ECS creates and attaches to each task,
+// configuring a task definition volume marked `configure_at_launch`. Resources that cannot update the
+// configuration in place set forceNew.
+func managedEBSVolumeSchema(forceNew bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"encrypted": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			ForceNew: forceNew,
+		},
+		"file_system_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     forceNew,
+			ValidateFunc: validation.StringInSlice(ecs.TaskFilesystemType_Values(), false),
+		},
+		"iops": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+			ForceNew: forceNew,
+		},
+		"kms_key_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     forceNew,
+			ValidateFunc: verify.ValidARN,
+		},
+		"role_arn": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     forceNew,
+			ValidateFunc: verify.ValidARN,
+		},
+		"size_in_gb": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+			ForceNew: forceNew,
+		},
+		"snapshot_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: forceNew,
+		},
+		"tag_specifications": {
+			Type:     schema.TypeList,
+			Optional: true,
+			ForceNew: forceNew,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"propagate_tags": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ForceNew:     forceNew,
+						ValidateFunc: validation.StringInSlice(ecs.PropagateTags_Values(), false),
+					},
+					"resource_type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     forceNew,
+						ValidateFunc: validation.StringInSlice(ecs.EBSResourceType_Values(), false),
+					},
+					"tags": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						ForceNew: forceNew,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"throughput": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+			ForceNew: forceNew,
+		},
+		"volume_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: forceNew,
+		},
+	}
+}
+
+func expandServiceVolumeConfigurations(tfList []interface{}) []*ecs.ServiceVolumeConfiguration {
+	var apiObjects []*ecs.ServiceVolumeConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ServiceVolumeConfiguration{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["managed_ebs_volume"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ManagedEBSVolume = expandServiceManagedEBSVolumeConfiguration(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandServiceManagedEBSVolumeConfiguration(tfMap map[string]interface{}) *ecs.ServiceManagedEBSVolumeConfiguration {
+	apiObject := &ecs.ServiceManagedEBSVolumeConfiguration{
+		RoleArn: aws.String(tfMap["role_arn"].(string)),
+	}
+
+	if v, ok := tfMap["encrypted"].(bool); ok && v {
+		apiObject.Encrypted = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["file_system_type"].(string); ok && v != "" {
+		apiObject.FilesystemType = aws.String(v)
+	}
+
+	if v, ok := tfMap["iops"].(int); ok && v != 0 {
+		apiObject.Iops = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["size_in_gb"].(int); ok && v != 0 {
+		apiObject.SizeInGiB = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["snapshot_id"].(string); ok && v != "" {
+		apiObject.SnapshotId = aws.String(v)
+	}
+
+	if v, ok := tfMap["tag_specifications"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TagSpecifications = expandEBSTagSpecifications(v)
+	}
+
+	if v, ok := tfMap["throughput"].(int); ok && v != 0 {
+		apiObject.Throughput = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["volume_type"].(string); ok && v != "" {
+		apiObject.VolumeType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTaskVolumeConfigurations(tfList []interface{}) []*ecs.TaskVolumeConfiguration {
+	var apiObjects []*ecs.TaskVolumeConfiguration
+
+	for _, v := range expandServiceVolumeConfigurations(tfList) {
+		apiObject := &ecs.TaskVolumeConfiguration{
+			Name: v.Name,
+		}
+
+		if v := v.ManagedEBSVolume; v != nil {
+			apiObject.ManagedEBSVolume = &ecs.TaskManagedEBSVolumeConfiguration{
+				Encrypted:         v.Encrypted,
+				FilesystemType:    v.FilesystemType,
+				Iops:              v.Iops,
+				KmsKeyId:          v.KmsKeyId,
+				RoleArn:           v.RoleArn,
+				SizeInGiB:         v.SizeInGiB,
+				SnapshotId:        v.SnapshotId,
+				TagSpecifications: v.TagSpecifications,
+				Throughput:        v.Throughput,
+				VolumeType:        v.VolumeType,
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandEBSTagSpecifications(tfList []interface{}) []*ecs.EBSTagSpecification {
+	var apiObjects []*ecs.EBSTagSpecification
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.EBSTagSpecification{
+			ResourceType: aws.String(tfMap["resource_type"].(string)),
+		}
+
+		if v, ok := tfMap["propagate_tags"].(string); ok && v != "" {
+			apiObject.PropagateTags = aws.String(v)
+		}
+
+		if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+			for k, v := range v {
+				apiObject.Tags = append(apiObject.Tags, &ecs.Tag{
+					Key:   aws.String(k),
+					Value: aws.String(v.(string)),
+				})
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenServiceVolumeConfigurations(apiObjects []*ecs.ServiceVolumeConfiguration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+		}
+
+		if v := apiObject.ManagedEBSVolume; v != nil {
+			tfMap["managed_ebs_volume"] = []interface{}{flattenServiceManagedEBSVolumeConfiguration(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenServiceManagedEBSVolumeConfiguration(apiObject *ecs.ServiceManagedEBSVolumeConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"encrypted":        aws.BoolValue(apiObject.Encrypted),
+		"file_system_type": aws.StringValue(apiObject.FilesystemType),
+		"iops":             aws.Int64Value(apiObject.Iops),
+		"kms_key_id":       aws.StringValue(apiObject.KmsKeyId),
+		"role_arn":         aws.StringValue(apiObject.RoleArn),
+		"size_in_gb":       aws.Int64Value(apiObject.SizeInGiB),
+		"snapshot_id":      aws.StringValue(apiObject.SnapshotId),
+		"throughput":       aws.Int64Value(apiObject.Throughput),
+		"volume_type":      aws.StringValue(apiObject.VolumeType),
+	}
+
+	if v := apiObject.TagSpecifications; len(v) > 0 {
+		tfMap["tag_specifications"] = flattenEBSTagSpecifications(v)
+	}
+
+	return tfMap
+}
+
+func flattenEBSTagSpecifications(apiObjects []*ecs.EBSTagSpecification) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tags := map[string]interface{}{}
+		for _, v := range apiObject.Tags {
+			tags[aws.StringValue(v.Key)] = aws.StringValue(v.Value)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"propagate_tags": aws.StringValue(apiObject.PropagateTags),
+			"resource_type":  aws.StringValue(apiObject.ResourceType),
+			"tags":           tags,
+		})
+	}
+
+	return tfList
+}
@@ -5,6 +5,9 @@ package ecs
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -29,6 +32,15 @@ const (
 
 	taskSetCreateTimeout = 10 * time.Minute
 	taskSetDeleteTimeout = 10 * time.Minute
+
+	taskRunTimeout  = 20 * time.Minute
+	taskStopTimeout = 10 * time.Minute
+)
+
+const (
+	// Limits on the detail included when a service deployment fails.
+	serviceDeploymentFailureMaxEvents       = 5
+	serviceDeploymentFailureMaxStoppedTasks = 5
 )
 
 func waitCapacityProviderDeleted(ctx context.Context, conn *ecs.ECS, arn string) (*ecs.CapacityProvider, error) {
@@ -84,6 +96,12 @@ func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, t
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
+	if err != nil {
+		if details := serviceDeploymentFailureDetails(ctx, conn, id, cluster); details != "" {
+			err = fmt.Errorf("%w\n\n%s", err, details)
+		}
+	}
+
 	if v, ok := outputRaw.(*ecs.Service); ok {
 		return v, err
 	}
@@ -91,6 +109,61 @@ func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, t
 	return nil, err
 }
 
+// serviceDeploymentFailureDetails summarizes an ECS Service's recent events and stopped tasks to explain why a
+// deployment did not reach a steady state. Any error while gathering details is logged and omitted.
+func serviceDeploymentFailureDetails(ctx context.Context, conn *ecs.ECS, id, cluster string) string {
+	service, err := FindServiceNoTagsByID(ctx, conn, id, cluster)
+
+	if err != nil {
+		log.Printf("[WARN] reading ECS Service (%s) for deployment failure details: %s", id, err)
+		return ""
+	}
+
+	var sb strings.Builder
+
+	if events := service.Events; len(events) > 0 {
+		sb.WriteString("Recent service events:")
+		// Events are returned newest first.
+		if len(events) > serviceDeploymentFailureMaxEvents {
+			events = events[:serviceDeploymentFailureMaxEvents]
+		}
+		for _, v := range events {
+			fmt.Fprintf(&sb, "\n  %s %s", aws.TimeValue(v.CreatedAt).Format(time.RFC3339), aws.StringValue(v.Message))
+		}
+	}
+
+	tasks, err := findStoppedServiceTasks(ctx, conn, service, serviceDeploymentFailureMaxStoppedTasks)
+
+	if err != nil {
+		log.Printf("[WARN] reading ECS Service (%s) stopped tasks for deployment failure details: %s", id, err)
+	}
+
+	if len(tasks) > 0 {
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString("Stopped tasks:")
+		for _, task := range tasks {
+			fmt.Fprintf(&sb, "\n  %s: %s", aws.StringValue(task.TaskArn), aws.StringValue(task.StoppedReason))
+			for _, container := range task.Containers {
+				if container.ExitCode == nil && container.Reason == nil {
+					continue
+				}
+
+				fmt.Fprintf(&sb, "\n    container %s", aws.StringValue(container.Name))
+				if container.ExitCode != nil {
+					fmt.Fprintf(&sb, " exited with code %d", aws.Int64Value(container.ExitCode))
+				}
+				if container.Reason != nil {
+					fmt.Fprintf(&sb, ": %s", aws.StringValue(container.Reason))
+				}
+			}
+		}
+	}
+
+	return sb.String()
+}
+
 // waitServiceInactive waits for an ECS Service to reach the status "INACTIVE".
 func waitServiceInactive(ctx context.Context, conn *ecs.ECS, id, cluster string, timeout time.Duration) error {
 	input := &ecs.DescribeServicesInput{
@@ -157,3 +230,30 @@ func waitTaskSetDeleted(ctx context.Context, conn *ecs.ECS, taskSetID, service,
 
 	return err
 }
+
+func waitTaskStopped(ctx context.Context, conn *ecs.ECS, taskARN, cluster string, timeout time.Duration) (*ecs.Task, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			taskStatusActivating,
+			taskStatusDeactivating,
+			taskStatusDeprovisioning,
+			taskStatusPending,
+			taskStatusProvisioning,
+			taskStatusRunning,
+			taskStatusStopping,
+		},
+		Target:     []string{taskStatusStopped},
+		Refresh:    statusTask(ctx, conn, taskARN, cluster),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ecs.Task); ok {
+		return output, err
+	}
+
+	return nil, err
+}
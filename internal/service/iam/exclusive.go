@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// exclusivePolicies describes a resource that exclusively manages either the inline policies
+// or the attached managed policies of a single IAM role, user or group.
+// Policies not in configuration are removed from the principal. Policies in configuration are
+// attached when the resource can add them, i.e. for managed policy attachments.
+// Inline policies are created by the corresponding policy resource, so they are never added here.
+type exclusivePolicies struct {
+	// Human-friendly resource name, e.g. "Role Policy Attachments Exclusive".
+	name string
+	// Principal type, e.g. "Role".
+	principalType string
+	// Attribute holding the principal's name, e.g. "role_name".
+	principalNameKey string
+	// Attribute holding the exclusively managed policies, e.g. "policy_arns".
+	policiesKey string
+	// Schema of each element of the policies attribute.
+	policySchema *schema.Schema
+	// Description of the managed policies used in messages, e.g. "attached policies".
+	policiesDescription string
+
+	list   func(ctx context.Context, conn *iam.IAM, principalName string) ([]string, error)
+	add    func(ctx context.Context, conn *iam.IAM, principalName, policy string) error
+	remove func(ctx context.Context, conn *iam.IAM, principalName, policy string) error
+}
+
+func (e *exclusivePolicies) resource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: e.put,
+		ReadWithoutTimeout:   e.read,
+		UpdateWithoutTimeout: e.put,
+		DeleteWithoutTimeout: e.delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set(e.principalNameKey, d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			e.policiesKey: {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     e.policySchema,
+			},
+			e.principalNameKey: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func (e *exclusivePolicies) put(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	principalName := d.Get(e.principalNameKey).(string)
+	policies, err := e.list(ctx, conn, principalName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM %s (%s) %s: %s", e.principalType, principalName, e.policiesDescription, err)
+	}
+
+	have := flex.FlattenStringValueSet(policies)
+	want := d.Get(e.policiesKey).(*schema.Set)
+
+	if e.add != nil {
+		for _, policy := range flex.ExpandStringValueSet(want.Difference(have)) {
+			if err := e.add(ctx, conn, principalName, policy); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	for _, policy := range flex.ExpandStringValueSet(have.Difference(want)) {
+		err := e.remove(ctx, conn, principalName, policy)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	d.SetId(principalName)
+
+	return append(diags, e.read(ctx, d, meta)...)
+}
+
+func (e *exclusivePolicies) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	policies, err := e.list(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM %s (%s) not found, removing from state", e.name, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM %s (%s): %s", e.name, d.Id(), err)
+	}
+
+	d.Set(e.policiesKey, flex.FlattenStringValueSet(policies))
+	d.Set(e.principalNameKey, d.Id())
+
+	return diags
+}
+
+func (e *exclusivePolicies) delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Removing exclusive management leaves the principal's current policies in place.
+	log.Printf("[DEBUG] Removing IAM %s (%s) from state", e.name, d.Id())
+
+	return nil
+}
+
+// exclusivePolicyError wraps an error from adding or removing a single policy.
+func exclusivePolicyError(action, policy, principalType, principalName string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s IAM %s (%s) policy (%s): %w", action, principalType, principalName, policy, err)
+}
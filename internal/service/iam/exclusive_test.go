@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// exclusivePoliciesTestCase describes the acceptance tests of one of the
+// aws_iam_{role,user,group}_{policies,policy_attachments}_exclusive resources.
+type exclusivePoliciesTestCase struct {
+	// Principal type as used in resource type names, e.g. "role".
+	principal string
+	// Whether the resource manages attached managed policies rather than inline policies.
+	attachments bool
+
+	checkDestroy func(context.Context) resource.TestCheckFunc
+	list         func(ctx context.Context, conn *iam.IAM, principalName string) ([]string, error)
+	// addOutOfBand adds a policy that is not in configuration to the principal.
+	addOutOfBand func(ctx context.Context, conn *iam.IAM, principalName string, s *terraform.State) error
+}
+
+const testAccExclusivePoliciesOutOfBandDocument = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:Describe*","Resource":"*"}]}`
+
+func (tc exclusivePoliciesTestCase) resourceName() string {
+	if tc.attachments {
+		return fmt.Sprintf("aws_iam_%s_policy_attachments_exclusive.test", tc.principal)
+	}
+
+	return fmt.Sprintf("aws_iam_%s_policies_exclusive.test", tc.principal)
+}
+
+func (tc exclusivePoliciesTestCase) policiesKey() string {
+	if tc.attachments {
+		return "policy_arns"
+	}
+
+	return "policy_names"
+}
+
+func (tc exclusivePoliciesTestCase) config(rName string) string {
+	if tc.attachments {
+		return testAccPolicyAttachmentsExclusiveConfig_basic(tc.principal, rName)
+	}
+
+	return testAccPoliciesExclusiveConfig_basic(tc.principal, rName)
+}
+
+func testAccExclusivePolicies_basic(t *testing.T, tc exclusivePoliciesTestCase) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := tc.resourceName()
+
+	policyResourceName, policyAttr := fmt.Sprintf("aws_iam_%s_policy.test", tc.principal), "name"
+	if tc.attachments {
+		policyResourceName, policyAttr = "aws_iam_policy.test", "arn"
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             tc.checkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: tc.config(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExclusivePoliciesCount(ctx, resourceName, tc.list, 1),
+					resource.TestCheckResourceAttrPair(resourceName, tc.principal+"_name", fmt.Sprintf("aws_iam_%s.test", tc.principal), "name"),
+					resource.TestCheckResourceAttr(resourceName, tc.policiesKey()+".#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, tc.policiesKey()+".*", policyResourceName, policyAttr),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccExclusivePolicies_outOfBandRemoval(t *testing.T, tc exclusivePoliciesTestCase) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := tc.resourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             tc.checkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: tc.config(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExclusivePoliciesCount(ctx, resourceName, tc.list, 1),
+					testAccCheckExclusivePoliciesAddOutOfBand(ctx, rName, tc.addOutOfBand),
+					testAccCheckExclusivePoliciesCount(ctx, resourceName, tc.list, 2),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: tc.config(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExclusivePoliciesCount(ctx, resourceName, tc.list, 1),
+					resource.TestCheckResourceAttr(resourceName, tc.policiesKey()+".#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckExclusivePoliciesCount(ctx context.Context, n string, list func(context.Context, *iam.IAM, string) ([]string, error), count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn(ctx)
+
+		policies, err := list(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(policies); got != count {
+			return fmt.Errorf("%s (%s) has %d policies, expected %d", rs.Type, rs.Primary.ID, got, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckExclusivePoliciesAddOutOfBand(ctx context.Context, principalName string, f func(context.Context, *iam.IAM, string, *terraform.State) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn(ctx)
+
+		return f(ctx, conn, principalName, s)
+	}
+}
+
+// testAccExclusivePoliciesAttachedPolicyARN returns the ARN of the policy created outside of exclusive management.
+func testAccExclusivePoliciesAttachedPolicyARN(s *terraform.State) (string, error) {
+	n := "aws_iam_policy.out_of_band"
+	rs, ok := s.RootModule().Resources[n]
+	if !ok {
+		return "", fmt.Errorf("Not found: %s", n)
+	}
+
+	return rs.Primary.Attributes["arn"], nil
+}
+
+func testAccExclusivePoliciesConfig_principal(principal, rName string) string {
+	if principal == "role" {
+		return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Action    = "sts:AssumeRole"
+      Principal = { Service = "ec2.amazonaws.com" }
+    }]
+  })
+}
+`, rName)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_iam_%[1]s" "test" {
+  name = %[2]q
+}
+`, principal, rName)
+}
+
+func testAccPoliciesExclusiveConfig_basic(principal, rName string) string {
+	return acctest.ConfigCompose(testAccExclusivePoliciesConfig_principal(principal, rName), fmt.Sprintf(`
+resource "aws_iam_%[1]s_policy" "test" {
+  name   = %[2]q
+  %[1]s   = aws_iam_%[1]s.test.name
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:ListAllMyBuckets"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_%[1]s_policies_exclusive" "test" {
+  %[1]s_name    = aws_iam_%[1]s.test.name
+  policy_names = [aws_iam_%[1]s_policy.test.name]
+}
+`, principal, rName))
+}
+
+func testAccPolicyAttachmentsExclusiveConfig_basic(principal, rName string) string {
+	return acctest.ConfigCompose(testAccExclusivePoliciesConfig_principal(principal, rName), fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name   = %[2]q
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:ListAllMyBuckets"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_policy" "out_of_band" {
+  name   = "%[2]s-out-of-band"
+  policy = %[3]q
+}
+
+resource "aws_iam_%[1]s_policy_attachments_exclusive" "test" {
+  %[1]s_name   = aws_iam_%[1]s.test.name
+  policy_arns = [aws_iam_policy.test.arn]
+}
+`, principal, rName, testAccExclusivePoliciesOutOfBandDocument))
+}
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+// Exports for use in tests only.
+var (
+	FindGroupAttachedPolicyARNs = findGroupAttachedPolicyARNs
+	FindGroupPolicyNames        = findGroupPolicyNames
+	FindRoleAttachedPolicyARNs  = findRoleAttachedPolicyARNs
+	FindRolePolicyNames         = findRolePolicyNames
+	FindUserAttachedPolicyARNs  = findUserAttachedPolicyARNs
+	FindUserPolicyNames         = findUserPolicyNames
+)
@@ -184,18 +184,9 @@ func FindGroupByName(ctx context.Context, conn *iam.IAM, name string) (*iam.Grou
 }
 
 func DeleteGroupPolicyAttachments(ctx context.Context, conn *iam.IAM, groupName string) error {
-	var attachedPolicies []*iam.AttachedPolicy
-	input := &iam.ListAttachedGroupPoliciesInput{
-		GroupName: aws.String(groupName),
-	}
-
-	err := conn.ListAttachedGroupPoliciesPagesWithContext(ctx, input, func(page *iam.ListAttachedGroupPoliciesOutput, lastPage bool) bool {
-		attachedPolicies = append(attachedPolicies, page.AttachedPolicies...)
+	policyARNs, err := findGroupAttachedPolicyARNs(ctx, conn, groupName)
 
-		return !lastPage
-	})
-
-	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+	if tfresource.NotFound(err) {
 		return nil
 	}
 
@@ -203,10 +194,10 @@ func DeleteGroupPolicyAttachments(ctx context.Context, conn *iam.IAM, groupName
 		return fmt.Errorf("listing IAM Group (%s) policy attachments for deletion: %w", groupName, err)
 	}
 
-	for _, attachedPolicy := range attachedPolicies {
+	for _, policyARN := range policyARNs {
 		input := &iam.DetachGroupPolicyInput{
 			GroupName: aws.String(groupName),
-			PolicyArn: attachedPolicy.PolicyArn,
+			PolicyArn: aws.String(policyARN),
 		}
 
 		_, err := conn.DetachGroupPolicyWithContext(ctx, input)
@@ -216,7 +207,7 @@ func DeleteGroupPolicyAttachments(ctx context.Context, conn *iam.IAM, groupName
 		}
 
 		if err != nil {
-			return fmt.Errorf("detaching IAM Group (%s) policy (%s): %w", groupName, aws.StringValue(attachedPolicy.PolicyArn), err)
+			return fmt.Errorf("detaching IAM Group (%s) policy (%s): %w", groupName, policyARN, err)
 		}
 	}
 
@@ -224,17 +215,9 @@ func DeleteGroupPolicyAttachments(ctx context.Context, conn *iam.IAM, groupName
 }
 
 func DeleteGroupPolicies(ctx context.Context, conn *iam.IAM, groupName string) error {
-	var inlinePolicies []*string
-	input := &iam.ListGroupPoliciesInput{
-		GroupName: aws.String(groupName),
-	}
-
-	err := conn.ListGroupPoliciesPagesWithContext(ctx, input, func(page *iam.ListGroupPoliciesOutput, lastPage bool) bool {
-		inlinePolicies = append(inlinePolicies, page.PolicyNames...)
-		return !lastPage
-	})
+	policyNames, err := findGroupPolicyNames(ctx, conn, groupName)
 
-	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+	if tfresource.NotFound(err) {
 		return nil
 	}
 
@@ -242,10 +225,10 @@ func DeleteGroupPolicies(ctx context.Context, conn *iam.IAM, groupName string) e
 		return fmt.Errorf("listing IAM Group (%s) inline policies for deletion: %w", groupName, err)
 	}
 
-	for _, policyName := range inlinePolicies {
+	for _, policyName := range policyNames {
 		input := &iam.DeleteGroupPolicyInput{
 			GroupName:  aws.String(groupName),
-			PolicyName: policyName,
+			PolicyName: aws.String(policyName),
 		}
 
 		_, err := conn.DeleteGroupPolicyWithContext(ctx, input)
@@ -255,7 +238,7 @@ func DeleteGroupPolicies(ctx context.Context, conn *iam.IAM, groupName string) e
 		}
 
 		if err != nil {
-			return fmt.Errorf("deleting IAM Group (%s) inline policy (%s): %w", groupName, aws.StringValue(policyName), err)
+			return fmt.Errorf("deleting IAM Group (%s) inline policy (%s): %w", groupName, policyName, err)
 		}
 	}
 
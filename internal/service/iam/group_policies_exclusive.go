@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// @SDKResource("aws_iam_group_policies_exclusive")
+func ResourceGroupPoliciesExclusive() *schema.Resource {
+	// Inline policies are created by aws_iam_group_policy, so only out-of-band policies are deleted here.
+	return (&exclusivePolicies{
+		name:                "Group Policies Exclusive",
+		principalType:       "Group",
+		principalNameKey:    "group_name",
+		policiesKey:         "policy_names",
+		policySchema:        &schema.Schema{Type: schema.TypeString},
+		policiesDescription: "inline policies",
+		list:                findGroupPolicyNames,
+		remove: func(ctx context.Context, conn *iam.IAM, groupName, policyName string) error {
+			_, err := conn.DeleteGroupPolicyWithContext(ctx, &iam.DeleteGroupPolicyInput{
+				PolicyName: aws.String(policyName),
+				GroupName:  aws.String(groupName),
+			})
+
+			return exclusivePolicyError("deleting", policyName, "Group", groupName, err)
+		},
+	}).resource()
+}
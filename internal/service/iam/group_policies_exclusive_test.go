@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+var testGroupPoliciesExclusive = exclusivePoliciesTestCase{
+	principal:    "group",
+	checkDestroy: testAccCheckGroupDestroy,
+	list:         tfiam.FindGroupPolicyNames,
+	addOutOfBand: func(ctx context.Context, conn *iam.IAM, groupName string, _ *terraform.State) error {
+		_, err := conn.PutGroupPolicyWithContext(ctx, &iam.PutGroupPolicyInput{
+			PolicyDocument: aws.String(testAccExclusivePoliciesOutOfBandDocument),
+			PolicyName:     aws.String(groupName + "-out-of-band"),
+			GroupName:      aws.String(groupName),
+		})
+
+		return err
+	},
+}
+
+func TestAccIAMGroupPoliciesExclusive_basic(t *testing.T) {
+	testAccExclusivePolicies_basic(t, testGroupPoliciesExclusive)
+}
+
+func TestAccIAMGroupPoliciesExclusive_outOfBandRemoval(t *testing.T) {
+	testAccExclusivePolicies_outOfBandRemoval(t, testGroupPoliciesExclusive)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_iam_group_policy_attachments_exclusive")
+func ResourceGroupPolicyAttachmentsExclusive() *schema.Resource {
+	return (&exclusivePolicies{
+		name:             "Group Policy Attachments Exclusive",
+		principalType:    "Group",
+		principalNameKey: "group_name",
+		policiesKey:      "policy_arns",
+		policySchema: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: verify.ValidARN,
+		},
+		policiesDescription: "attached policies",
+		list:                findGroupAttachedPolicyARNs,
+		add: func(ctx context.Context, conn *iam.IAM, groupName, policyARN string) error {
+			_, err := conn.AttachGroupPolicyWithContext(ctx, &iam.AttachGroupPolicyInput{
+				PolicyArn: aws.String(policyARN),
+				GroupName: aws.String(groupName),
+			})
+
+			return exclusivePolicyError("attaching", policyARN, "Group", groupName, err)
+		},
+		remove: func(ctx context.Context, conn *iam.IAM, groupName, policyARN string) error {
+			_, err := conn.DetachGroupPolicyWithContext(ctx, &iam.DetachGroupPolicyInput{
+				PolicyArn: aws.String(policyARN),
+				GroupName: aws.String(groupName),
+			})
+
+			return exclusivePolicyError("detaching", policyARN, "Group", groupName, err)
+		},
+	}).resource()
+}
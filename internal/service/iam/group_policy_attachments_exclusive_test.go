@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+var testGroupPolicyAttachmentsExclusive = exclusivePoliciesTestCase{
+	principal:    "group",
+	attachments:  true,
+	checkDestroy: testAccCheckGroupDestroy,
+	list:         tfiam.FindGroupAttachedPolicyARNs,
+	addOutOfBand: func(ctx context.Context, conn *iam.IAM, groupName string, s *terraform.State) error {
+		policyARN, err := testAccExclusivePoliciesAttachedPolicyARN(s)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.AttachGroupPolicyWithContext(ctx, &iam.AttachGroupPolicyInput{
+			PolicyArn: aws.String(policyARN),
+			GroupName: aws.String(groupName),
+		})
+
+		return err
+	},
+}
+
+func TestAccIAMGroupPolicyAttachmentsExclusive_basic(t *testing.T) {
+	testAccExclusivePolicies_basic(t, testGroupPolicyAttachmentsExclusive)
+}
+
+func TestAccIAMGroupPolicyAttachmentsExclusive_outOfBandRemoval(t *testing.T) {
+	testAccExclusivePolicies_outOfBandRemoval(t, testGroupPolicyAttachmentsExclusive)
+}
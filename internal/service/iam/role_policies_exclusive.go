@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// @SDKResource("aws_iam_role_policies_exclusive")
+func ResourceRolePoliciesExclusive() *schema.Resource {
+	// Inline policies are created by aws_iam_role_policy, so only out-of-band policies are deleted here.
+	return (&exclusivePolicies{
+		name:                "Role Policies Exclusive",
+		principalType:       "Role",
+		principalNameKey:    "role_name",
+		policiesKey:         "policy_names",
+		policySchema:        &schema.Schema{Type: schema.TypeString},
+		policiesDescription: "inline policies",
+		list:                findRolePolicyNames,
+		remove: func(ctx context.Context, conn *iam.IAM, roleName, policyName string) error {
+			_, err := conn.DeleteRolePolicyWithContext(ctx, &iam.DeleteRolePolicyInput{
+				PolicyName: aws.String(policyName),
+				RoleName:   aws.String(roleName),
+			})
+
+			return exclusivePolicyError("deleting", policyName, "Role", roleName, err)
+		},
+	}).resource()
+}
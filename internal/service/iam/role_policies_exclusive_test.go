@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+var testRolePoliciesExclusive = exclusivePoliciesTestCase{
+	principal:    "role",
+	checkDestroy: testAccCheckRoleDestroy,
+	list:         tfiam.FindRolePolicyNames,
+	addOutOfBand: func(ctx context.Context, conn *iam.IAM, roleName string, _ *terraform.State) error {
+		_, err := conn.PutRolePolicyWithContext(ctx, &iam.PutRolePolicyInput{
+			PolicyDocument: aws.String(testAccExclusivePoliciesOutOfBandDocument),
+			PolicyName:     aws.String(roleName + "-out-of-band"),
+			RoleName:       aws.String(roleName),
+		})
+
+		return err
+	},
+}
+
+func TestAccIAMRolePoliciesExclusive_basic(t *testing.T) {
+	testAccExclusivePolicies_basic(t, testRolePoliciesExclusive)
+}
+
+func TestAccIAMRolePoliciesExclusive_outOfBandRemoval(t *testing.T) {
+	testAccExclusivePolicies_outOfBandRemoval(t, testRolePoliciesExclusive)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_iam_role_policy_attachments_exclusive")
+func ResourceRolePolicyAttachmentsExclusive() *schema.Resource {
+	return (&exclusivePolicies{
+		name:             "Role Policy Attachments Exclusive",
+		principalType:    "Role",
+		principalNameKey: "role_name",
+		policiesKey:      "policy_arns",
+		policySchema: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: verify.ValidARN,
+		},
+		policiesDescription: "attached policies",
+		list:                findRoleAttachedPolicyARNs,
+		add: func(ctx context.Context, conn *iam.IAM, roleName, policyARN string) error {
+			_, err := conn.AttachRolePolicyWithContext(ctx, &iam.AttachRolePolicyInput{
+				PolicyArn: aws.String(policyARN),
+				RoleName:  aws.String(roleName),
+			})
+
+			return exclusivePolicyError("attaching", policyARN, "Role", roleName, err)
+		},
+		remove: func(ctx context.Context, conn *iam.IAM, roleName, policyARN string) error {
+			_, err := conn.DetachRolePolicyWithContext(ctx, &iam.DetachRolePolicyInput{
+				PolicyArn: aws.String(policyARN),
+				RoleName:  aws.String(roleName),
+			})
+
+			return exclusivePolicyError("detaching", policyARN, "Role", roleName, err)
+		},
+	}).resource()
+}
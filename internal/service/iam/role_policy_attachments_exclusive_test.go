@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+var testRolePolicyAttachmentsExclusive = exclusivePoliciesTestCase{
+	principal:    "role",
+	attachments:  true,
+	checkDestroy: testAccCheckRoleDestroy,
+	list:         tfiam.FindRoleAttachedPolicyARNs,
+	addOutOfBand: func(ctx context.Context, conn *iam.IAM, roleName string, s *terraform.State) error {
+		policyARN, err := testAccExclusivePoliciesAttachedPolicyARN(s)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.AttachRolePolicyWithContext(ctx, &iam.AttachRolePolicyInput{
+			PolicyArn: aws.String(policyARN),
+			RoleName:  aws.String(roleName),
+		})
+
+		return err
+	},
+}
+
+func TestAccIAMRolePolicyAttachmentsExclusive_basic(t *testing.T) {
+	testAccExclusivePolicies_basic(t, testRolePolicyAttachmentsExclusive)
+}
+
+func TestAccIAMRolePolicyAttachmentsExclusive_outOfBandRemoval(t *testing.T) {
+	testAccExclusivePolicies_outOfBandRemoval(t, testRolePolicyAttachmentsExclusive)
+}
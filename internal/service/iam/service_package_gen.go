@@ -118,6 +118,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGroupMembership,
 			TypeName: "aws_iam_group_membership",
 		},
+		{
+			Factory:  ResourceGroupPoliciesExclusive,
+			TypeName: "aws_iam_group_policies_exclusive",
+		},
 		{
 			Factory:  ResourceGroupPolicy,
 			TypeName: "aws_iam_group_policy",
@@ -126,6 +130,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGroupPolicyAttachment,
 			TypeName: "aws_iam_group_policy_attachment",
 		},
+		{
+			Factory:  ResourceGroupPolicyAttachmentsExclusive,
+			TypeName: "aws_iam_group_policy_attachments_exclusive",
+		},
 		{
 			Factory:  ResourceInstanceProfile,
 			TypeName: "aws_iam_instance_profile",
@@ -154,6 +162,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Role",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  ResourceRolePoliciesExclusive,
+			TypeName: "aws_iam_role_policies_exclusive",
+		},
 		{
 			Factory:  ResourceRolePolicy,
 			TypeName: "aws_iam_role_policy",
@@ -162,6 +174,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceRolePolicyAttachment,
 			TypeName: "aws_iam_role_policy_attachment",
 		},
+		{
+			Factory:  ResourceRolePolicyAttachmentsExclusive,
+			TypeName: "aws_iam_role_policy_attachments_exclusive",
+		},
 		{
 			Factory:  ResourceSAMLProvider,
 			TypeName: "aws_iam_saml_provider",
@@ -202,6 +218,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceUserLoginProfile,
 			TypeName: "aws_iam_user_login_profile",
 		},
+		{
+			Factory:  ResourceUserPoliciesExclusive,
+			TypeName: "aws_iam_user_policies_exclusive",
+		},
 		{
 			Factory:  ResourceUserPolicy,
 			TypeName: "aws_iam_user_policy",
@@ -210,6 +230,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceUserPolicyAttachment,
 			TypeName: "aws_iam_user_policy_attachment",
 		},
+		{
+			Factory:  ResourceUserPolicyAttachmentsExclusive,
+			TypeName: "aws_iam_user_policy_attachments_exclusive",
+		},
 		{
 			Factory:  ResourceUserSSHKey,
 			TypeName: "aws_iam_user_ssh_key",
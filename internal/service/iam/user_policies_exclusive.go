@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// @SDKResource("aws_iam_user_policies_exclusive")
+func ResourceUserPoliciesExclusive() *schema.Resource {
+	// Inline policies are created by aws_iam_user_policy, so only out-of-band policies are deleted here.
+	return (&exclusivePolicies{
+		name:                "User Policies Exclusive",
+		principalType:       "User",
+		principalNameKey:    "user_name",
+		policiesKey:         "policy_names",
+		policySchema:        &schema.Schema{Type: schema.TypeString},
+		policiesDescription: "inline policies",
+		list:                findUserPolicyNames,
+		remove: func(ctx context.Context, conn *iam.IAM, userName, policyName string) error {
+			_, err := conn.DeleteUserPolicyWithContext(ctx, &iam.DeleteUserPolicyInput{
+				PolicyName: aws.String(policyName),
+				UserName:   aws.String(userName),
+			})
+
+			return exclusivePolicyError("deleting", policyName, "User", userName, err)
+		},
+	}).resource()
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+var testUserPoliciesExclusive = exclusivePoliciesTestCase{
+	principal:    "user",
+	checkDestroy: testAccCheckUserDestroy,
+	list:         tfiam.FindUserPolicyNames,
+	addOutOfBand: func(ctx context.Context, conn *iam.IAM, userName string, _ *terraform.State) error {
+		_, err := conn.PutUserPolicyWithContext(ctx, &iam.PutUserPolicyInput{
+			PolicyDocument: aws.String(testAccExclusivePoliciesOutOfBandDocument),
+			PolicyName:     aws.String(userName + "-out-of-band"),
+			UserName:       aws.String(userName),
+		})
+
+		return err
+	},
+}
+
+func TestAccIAMUserPoliciesExclusive_basic(t *testing.T) {
+	testAccExclusivePolicies_basic(t, testUserPoliciesExclusive)
+}
+
+func TestAccIAMUserPoliciesExclusive_outOfBandRemoval(t *testing.T) {
+	testAccExclusivePolicies_outOfBandRemoval(t, testUserPoliciesExclusive)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_iam_user_policy_attachments_exclusive")
+func ResourceUserPolicyAttachmentsExclusive() *schema.Resource {
+	return (&exclusivePolicies{
+		name:             "User Policy Attachments Exclusive",
+		principalType:    "User",
+		principalNameKey: "user_name",
+		policiesKey:      "policy_arns",
+		policySchema: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: verify.ValidARN,
+		},
+		policiesDescription: "attached policies",
+		list:                findUserAttachedPolicyARNs,
+		add: func(ctx context.Context, conn *iam.IAM, userName, policyARN string) error {
+			_, err := conn.AttachUserPolicyWithContext(ctx, &iam.AttachUserPolicyInput{
+				PolicyArn: aws.String(policyARN),
+				UserName:  aws.String(userName),
+			})
+
+			return exclusivePolicyError("attaching", policyARN, "User", userName, err)
+		},
+		remove: func(ctx context.Context, conn *iam.IAM, userName, policyARN string) error {
+			_, err := conn.DetachUserPolicyWithContext(ctx, &iam.DetachUserPolicyInput{
+				PolicyArn: aws.String(policyARN),
+				UserName:  aws.String(userName),
+			})
+
+			return exclusivePolicyError("detaching", policyARN, "User", userName, err)
+		},
+	}).resource()
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+var testUserPolicyAttachmentsExclusive = exclusivePoliciesTestCase{
+	principal:    "user",
+	attachments:  true,
+	checkDestroy: testAccCheckUserDestroy,
+	list:         tfiam.FindUserAttachedPolicyARNs,
+	addOutOfBand: func(ctx context.Context, conn *iam.IAM, userName string, s *terraform.State) error {
+		policyARN, err := testAccExclusivePoliciesAttachedPolicyARN(s)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.AttachUserPolicyWithContext(ctx, &iam.AttachUserPolicyInput{
+			PolicyArn: aws.String(policyARN),
+			UserName:  aws.String(userName),
+		})
+
+		return err
+	},
+}
+
+func TestAccIAMUserPolicyAttachmentsExclusive_basic(t *testing.T) {
+	testAccExclusivePolicies_basic(t, testUserPolicyAttachmentsExclusive)
+}
+
+func TestAccIAMUserPolicyAttachmentsExclusive_outOfBandRemoval(t *testing.T) {
+	testAccExclusivePolicies_outOfBandRemoval(t, testUserPolicyAttachmentsExclusive)
+}
@@ -29,7 +29,14 @@ func ResourceAlias() *schema.Resource {
 			StateContext: resourceAliasImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(aliasUpdateTimeoutDefault),
+		},
+
+		CustomizeDiff: customizeDiffAliasDeployment,
+
 		Schema: map[string]*schema.Schema{
+			"deployment_config": aliasDeploymentConfigSchema(),
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -185,6 +192,34 @@ func resourceAliasUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		RoutingConfig:   expandAliasRoutingConfiguration(d.Get("routing_config").([]interface{})),
 	}
 
+	if deployment := expandAliasDeployment(d.Get("deployment_config").([]interface{})); deployment != nil && d.HasChange("function_version") {
+		o, n := d.GetChange("function_version")
+		oldRoutingConfig, _ := d.GetChange("routing_config")
+
+		shiftCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+		defer cancel()
+
+		log.Printf("[DEBUG] Shifting Lambda alias traffic from version %s to %s", o, n)
+		restored, err := shiftAliasTraffic(shiftCtx, conn, meta.(*conns.AWSClient).CloudWatchConn(ctx), deployment, params, o.(string), expandAliasRoutingConfiguration(oldRoutingConfig.([]interface{})))
+
+		if err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "updating Lambda alias: %s", err)
+
+			if restored {
+				// The alias was left on, or restored to, its previous version.
+				d.Set("function_version", o)
+				d.Set("routing_config", oldRoutingConfig)
+
+				return diags
+			}
+
+			// The rollback failed part way through the shift, so record the alias as it is now.
+			return append(diags, resourceAliasRead(ctx, d, meta)...)
+		}
+
+		return diags
+	}
+
 	_, err := conn.UpdateAliasWithContext(ctx, params)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating Lambda alias: %s", err)
@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// aliasDeploymentAlarmPollInterval is how often alarms are checked while waiting between steps.
+	aliasDeploymentAlarmPollInterval = 15 * time.Second
+	// aliasDeploymentRollbackTimeout bounds the rollback when the apply's own context is already done.
+	aliasDeploymentRollbackTimeout = 2 * time.Minute
+	// aliasUpdateTimeoutDefault is the default update timeout, which bounds the traffic shift.
+	aliasUpdateTimeoutDefault = 30 * time.Minute
+)
+
+func aliasDeploymentConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"alarms": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					MaxItems: 100,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"interval_in_seconds": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 3600),
+				},
+				"percentage": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 99),
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(aliasDeploymentType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+// customizeDiffAliasDeployment checks that a traffic shift can complete within the update timeout.
+func customizeDiffAliasDeployment(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("function_version") {
+		return nil
+	}
+
+	deployment := expandAliasDeployment(d.Get("deployment_config").([]interface{}))
+	if deployment == nil {
+		return nil
+	}
+
+	timeout, err := aliasUpdateTimeout(d.GetRawConfig())
+	if err != nil {
+		return err
+	}
+
+	if duration := deployment.duration(); duration >= timeout {
+		return fmt.Errorf("deployment_config takes %s to shift traffic, which is not less than the %s update timeout", duration, timeout)
+	}
+
+	return nil
+}
+
+// aliasUpdateTimeout returns the configured update timeout, or the default if it is not set.
+func aliasUpdateTimeout(rawConfig cty.Value) (time.Duration, error) {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().HasAttribute(schema.TimeoutsConfigKey) {
+		return aliasUpdateTimeoutDefault, nil
+	}
+
+	timeouts := rawConfig.GetAttr(schema.TimeoutsConfigKey)
+	if timeouts.IsNull() || !timeouts.IsKnown() || !timeouts.Type().HasAttribute(schema.TimeoutUpdate) {
+		return aliasUpdateTimeoutDefault, nil
+	}
+
+	v := timeouts.GetAttr(schema.TimeoutUpdate)
+	if v.IsNull() || !v.IsKnown() {
+		return aliasUpdateTimeoutDefault, nil
+	}
+
+	timeout, err := time.ParseDuration(v.AsString())
+	if err != nil {
+		return 0, fmt.Errorf("parsing update timeout (%s): %w", v.AsString(), err)
+	}
+
+	return timeout, nil
+}
+
+type aliasDeployment struct {
+	alarms     []string
+	interval   time.Duration
+	percentage int
+	typ        string
+}
+
+func expandAliasDeployment(tfList []interface{}) *aliasDeployment {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	deployment := &aliasDeployment{
+		interval:   time.Duration(tfMap["interval_in_seconds"].(int)) * time.Second,
+		percentage: tfMap["percentage"].(int),
+		typ:        tfMap["type"].(string),
+	}
+
+	if v, ok := tfMap["alarms"].(*schema.Set); ok {
+		for _, v := range v.List() {
+			deployment.alarms = append(deployment.alarms, v.(string))
+		}
+	}
+
+	return deployment
+}
+
+// steps returns the successive weights, as fractions, of traffic routed to the new version
+// before it is promoted to receive all traffic.
+func (d *aliasDeployment) steps() []float64 {
+	var steps []float64
+
+	switch d.typ {
+	case aliasDeploymentTypeCanary:
+		steps = append(steps, float64(d.percentage)/100)
+	case aliasDeploymentTypeLinear:
+		for p := d.percentage; p < 100; p += d.percentage {
+			steps = append(steps, float64(p)/100)
+		}
+	}
+
+	return steps
+}
+
+// duration returns the minimum time the deployment takes to shift all traffic.
+func (d *aliasDeployment) duration() time.Duration {
+	return d.interval * time.Duration(len(d.steps()))
+}
+
+// stepWeights returns the additional version weights while weight of the traffic served by fromVersion
+// is routed to toVersion. Weights to other versions are kept, so the shift only applies to the remaining traffic.
+func (d *aliasDeployment) stepWeights(fromRoutingConfig *lambda.AliasRoutingConfiguration, fromVersion, toVersion string, weight float64) map[string]*float64 {
+	weights := make(map[string]*float64)
+	remaining := 1.0
+
+	if fromRoutingConfig != nil {
+		for k, v := range fromRoutingConfig.AdditionalVersionWeights {
+			if k == fromVersion || k == toVersion || v == nil {
+				continue
+			}
+
+			weights[k] = aws.Float64(aws.Float64Value(v))
+			remaining -= aws.Float64Value(v)
+		}
+	}
+
+	weights[toVersion] = aws.Float64(weight * remaining)
+
+	return weights
+}
+
+// shiftAliasTraffic moves traffic on an alias from its current version to a new one in steps,
+// checking the deployment's alarms after each step. If an alarm fires, or the shift fails part way,
+// the alias is restored to its current version and routing configuration.
+// The returned bool reports whether, on error, the alias is known to be on its current version and routing configuration.
+func shiftAliasTraffic(ctx context.Context, conn *lambda.Lambda, cwConn *cloudwatch.CloudWatch, deployment *aliasDeployment, input *lambda.UpdateAliasInput, fromVersion string, fromRoutingConfig *lambda.AliasRoutingConfiguration) (bool, error) {
+	aliasName := fmt.Sprintf("%s:%s", aws.StringValue(input.FunctionName), aws.StringValue(input.Name))
+	toVersion := aws.StringValue(input.FunctionVersion)
+
+	rollback := func(cause error) (bool, error) {
+		rollbackCtx := ctx
+		if ctx.Err() != nil {
+			var cancel context.CancelFunc
+			rollbackCtx, cancel = context.WithTimeout(context.Background(), aliasDeploymentRollbackTimeout)
+			defer cancel()
+		}
+
+		log.Printf("[WARN] Rolling back Lambda Alias (%s) to version %s: %s", aliasName, fromVersion, cause)
+		_, err := conn.UpdateAliasWithContext(rollbackCtx, &lambda.UpdateAliasInput{
+			FunctionName:    input.FunctionName,
+			FunctionVersion: aws.String(fromVersion),
+			Name:            input.Name,
+			RoutingConfig:   fromRoutingConfig,
+		})
+
+		if err != nil {
+			return false, fmt.Errorf("%w; rolling back to version %s: %s", cause, fromVersion, err)
+		}
+
+		return true, fmt.Errorf("%w; rolled back to version %s", cause, fromVersion)
+	}
+
+	if err := checkAliasDeploymentAlarms(ctx, cwConn, deployment.alarms); err != nil {
+		return true, fmt.Errorf("shifting traffic to version %s: %w", toVersion, err)
+	}
+
+	for _, weight := range deployment.steps() {
+		log.Printf("[DEBUG] Shifting %.0f%% of Lambda Alias (%s) traffic to version %s", weight*100, aliasName, toVersion)
+		_, err := conn.UpdateAliasWithContext(ctx, &lambda.UpdateAliasInput{
+			Description:     input.Description,
+			FunctionName:    input.FunctionName,
+			FunctionVersion: aws.String(fromVersion),
+			Name:            input.Name,
+			RoutingConfig: &lambda.AliasRoutingConfiguration{
+				AdditionalVersionWeights: deployment.stepWeights(fromRoutingConfig, fromVersion, toVersion, weight),
+			},
+		})
+
+		if err != nil {
+			return rollback(fmt.Errorf("shifting %.0f%% of traffic to version %s: %w", weight*100, toVersion, err))
+		}
+
+		if err := waitAliasDeploymentInterval(ctx, cwConn, deployment); err != nil {
+			return rollback(fmt.Errorf("shifting %.0f%% of traffic to version %s: %w", weight*100, toVersion, err))
+		}
+	}
+
+	if _, err := conn.UpdateAliasWithContext(ctx, input); err != nil {
+		return rollback(fmt.Errorf("shifting all traffic to version %s: %w", toVersion, err))
+	}
+
+	return false, nil
+}
+
+// waitAliasDeploymentInterval waits for the deployment's interval, failing as soon as any of its alarms fires.
+func waitAliasDeploymentInterval(ctx context.Context, conn *cloudwatch.CloudWatch, deployment *aliasDeployment) error {
+	deadline := time.Now().Add(deployment.interval)
+
+	for {
+		wait := time.Until(deadline)
+		if wait > aliasDeploymentAlarmPollInterval {
+			wait = aliasDeploymentAlarmPollInterval
+		}
+
+		if wait > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+
+		if err := checkAliasDeploymentAlarms(ctx, conn, deployment.alarms); err != nil {
+			return err
+		}
+
+		if !time.Now().Before(deadline) {
+			return nil
+		}
+	}
+}
+
+// checkAliasDeploymentAlarms returns an error if any of the named metric or composite alarms is in the ALARM state.
+func checkAliasDeploymentAlarms(ctx context.Context, conn *cloudwatch.CloudWatch, alarmNames []string) error {
+	if len(alarmNames) == 0 {
+		return nil
+	}
+
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: aws.StringSlice(alarmNames),
+		AlarmTypes: aws.StringSlice(cloudwatch.AlarmType_Values()),
+	}
+	found := make(map[string]bool, len(alarmNames))
+	var firing []string
+
+	err := conn.DescribeAlarmsPagesWithContext(ctx, input, func(page *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MetricAlarms {
+			found[aws.StringValue(v.AlarmName)] = true
+			if aws.StringValue(v.StateValue) == cloudwatch.StateValueAlarm {
+				firing = append(firing, aws.StringValue(v.AlarmName))
+			}
+		}
+
+		for _, v := range page.CompositeAlarms {
+			found[aws.StringValue(v.AlarmName)] = true
+			if aws.StringValue(v.StateValue) == cloudwatch.StateValueAlarm {
+				firing = append(firing, aws.StringValue(v.AlarmName))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading CloudWatch Alarms: %w", err)
+	}
+
+	for _, v := range alarmNames {
+		if !found[v] {
+			return fmt.Errorf("CloudWatch Alarm (%s) not found", v)
+		}
+	}
+
+	if len(firing) > 0 {
+		return fmt.Errorf("CloudWatch Alarms in ALARM state: %s", strings.Join(firing, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestAliasDeploymentSteps(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Type       string
+		Percentage int
+		Expected   []float64
+	}{
+		{Type: aliasDeploymentTypeCanary, Percentage: 10, Expected: []float64{0.1}},
+		{Type: aliasDeploymentTypeLinear, Percentage: 25, Expected: []float64{0.25, 0.5, 0.75}},
+		{Type: aliasDeploymentTypeLinear, Percentage: 30, Expected: []float64{0.3, 0.6, 0.9}},
+		{Type: aliasDeploymentTypeLinear, Percentage: 50, Expected: []float64{0.5}},
+		{Type: aliasDeploymentTypeLinear, Percentage: 99, Expected: []float64{0.99}},
+	}
+
+	for _, testCase := range testCases {
+		deployment := &aliasDeployment{percentage: testCase.Percentage, typ: testCase.Type}
+
+		if got := deployment.steps(); !reflect.DeepEqual(got, testCase.Expected) {
+			t.Errorf("%s %d%% steps = %v, expected %v", testCase.Type, testCase.Percentage, got, testCase.Expected)
+		}
+	}
+}
+
+func TestAliasDeploymentStepWeights(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name              string
+		FromRoutingConfig *lambda.AliasRoutingConfiguration
+		Weight            float64
+		Expected          map[string]float64
+	}{
+		{
+			Name:     "no routing config",
+			Weight:   0.1,
+			Expected: map[string]float64{"3": 0.1},
+		},
+		{
+			Name: "replaces weight to new version",
+			FromRoutingConfig: &lambda.AliasRoutingConfiguration{
+				AdditionalVersionWeights: map[string]*float64{"3": aws.Float64(0.5)},
+			},
+			Weight:   0.25,
+			Expected: map[string]float64{"3": 0.25},
+		},
+		{
+			Name: "keeps weights to other versions",
+			FromRoutingConfig: &lambda.AliasRoutingConfiguration{
+				AdditionalVersionWeights: map[string]*float64{"1": aws.Float64(0.5)},
+			},
+			Weight:   0.5,
+			Expected: map[string]float64{"1": 0.5, "3": 0.25},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			deployment := &aliasDeployment{percentage: 10, typ: aliasDeploymentTypeCanary}
+			got := aws.Float64ValueMap(deployment.stepWeights(testCase.FromRoutingConfig, "2", "3", testCase.Weight))
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAliasUpdateTimeout(t *testing.T) {
+	t.Parallel()
+
+	timeoutsType := cty.Object(map[string]cty.Type{"update": cty.String})
+	configType := cty.Object(map[string]cty.Type{"name": cty.String, "timeouts": timeoutsType})
+
+	testCases := []struct {
+		Name      string
+		RawConfig cty.Value
+		Expected  time.Duration
+		ExpectErr bool
+	}{
+		{
+			Name:      "null config",
+			RawConfig: cty.NullVal(configType),
+			Expected:  aliasUpdateTimeoutDefault,
+		},
+		{
+			Name: "no timeouts",
+			RawConfig: cty.ObjectVal(map[string]cty.Value{
+				"name":     cty.StringVal("test"),
+				"timeouts": cty.NullVal(timeoutsType),
+			}),
+			Expected: aliasUpdateTimeoutDefault,
+		},
+		{
+			Name: "update timeout",
+			RawConfig: cty.ObjectVal(map[string]cty.Value{
+				"name":     cty.StringVal("test"),
+				"timeouts": cty.ObjectVal(map[string]cty.Value{"update": cty.StringVal("1h")}),
+			}),
+			Expected: time.Hour,
+		},
+		{
+			Name: "invalid update timeout",
+			RawConfig: cty.ObjectVal(map[string]cty.Value{
+				"name":     cty.StringVal("test"),
+				"timeouts": cty.ObjectVal(map[string]cty.Value{"update": cty.StringVal("soon")}),
+			}),
+			ExpectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := aliasUpdateTimeout(testCase.RawConfig)
+
+			if testCase.ExpectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
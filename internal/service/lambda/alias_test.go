@@ -198,6 +198,47 @@ func TestAccLambdaAlias_routing(t *testing.T) {
 	})
 }
 
+func TestAccLambdaAlias_deploymentConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.AliasConfiguration
+	resourceName := "aws_lambda_alias.test"
+
+	rString := sdkacctest.RandString(8)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_alias_basic_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_alias_basic_%s", rString)
+	attachmentName := fmt.Sprintf("tf_acc_attachment_%s", rString)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_alias_basic_%s", rString)
+	aliasName := fmt.Sprintf("tf_acc_lambda_alias_basic_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_deploymentConfig(roleName, policyName, attachmentName, funcName, aliasName, "lambdatest.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "deployment_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_config.0.type", "Linear"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_config.0.percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_config.0.alarms.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "1"),
+				),
+			},
+			{
+				Config: testAccAliasConfig_deploymentConfig(roleName, policyName, attachmentName, funcName, aliasName, "lambdatest_modified.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &conf),
+					testAccCheckAliasRoutingDoesNotExistConfig(&conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAliasDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn(ctx)
@@ -431,3 +472,49 @@ resource "aws_lambda_alias" "test" {
 }
 `, funcName, aliasName))
 }
+
+func testAccAliasConfig_deploymentConfig(roleName, policyName, attachmentName, funcName, aliasName, filename string) string {
+	return acctest.ConfigCompose(
+		testAccAliasConfig_base(roleName, policyName, attachmentName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename         = "test-fixtures/%[3]s"
+  function_name    = %[1]q
+  role             = aws_iam_role.iam_for_lambda.arn
+  handler          = "exports.example"
+  runtime          = "nodejs16.x"
+  source_code_hash = filebase64sha256("test-fixtures/%[3]s")
+  publish          = "true"
+}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanThreshold"
+  evaluation_periods  = 1
+  metric_name         = "Errors"
+  namespace           = "AWS/Lambda"
+  period              = 60
+  statistic           = "Sum"
+  threshold           = 0
+  treat_missing_data  = "notBreaching"
+
+  dimensions = {
+    FunctionName = aws_lambda_function.test.function_name
+  }
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = %[2]q
+  description      = "a sample description"
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+
+  deployment_config {
+    type                = "Linear"
+    percentage          = 50
+    interval_in_seconds = 0
+    alarms              = [aws_cloudwatch_metric_alarm.test.alarm_name]
+  }
+}
+`, funcName, aliasName, filename))
+}
@@ -38,3 +38,15 @@ func lifecycleScope_Values() []string {
 		lifecycleScopeCrud,
 	}
 }
+
+const (
+	aliasDeploymentTypeCanary = "Canary"
+	aliasDeploymentTypeLinear = "Linear"
+)
+
+func aliasDeploymentType_Values() []string {
+	return []string{
+		aliasDeploymentTypeCanary,
+		aliasDeploymentTypeLinear,
+	}
+}
@@ -169,7 +169,7 @@ func ResourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -208,7 +208,7 @@ func ResourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -232,6 +232,42 @@ func ResourceFunction() *schema.Resource {
 					ValidateFunc: verify.ValidARN,
 				},
 			},
+			"logging_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_log_level": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.ApplicationLogLevel](),
+						},
+						"log_format": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.LogFormat](),
+						},
+						"log_group": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 512),
+								validation.StringMatch(regexp.MustCompile(`^[\.\-_/#A-Za-z0-9]+$`), "must be a valid CloudWatch Logs log group name"),
+							),
+						},
+						"system_log_level": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.SystemLogLevel](),
+						},
+					},
+				},
+			},
 			"memory_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -291,7 +327,7 @@ func ResourceFunction() *schema.Resource {
 			"s3_bucket": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -302,7 +338,7 @@ func ResourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -335,9 +371,45 @@ func ResourceFunction() *schema.Resource {
 					},
 				},
 			},
+			"source": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dependency_manifest": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"directory": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"excludes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"s3_bucket": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"s3_key_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"source_code_hash": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"source"},
+			},
+			"source_content_hash": {
 				Type:     schema.TypeString,
-				Optional: true,
 				Computed: true,
 			},
 			"source_code_size": {
@@ -416,6 +488,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			computeSourceCodeHashFromSource,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -459,6 +532,19 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Code.ZipFile = zipFile
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
+	} else if _, ok := d.GetOk("source"); ok {
+		zipFile, s3Bucket, s3Key, err := functionSourceCode(ctx, d, meta)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): %s", functionName, err)
+		}
+
+		if zipFile != nil {
+			input.Code.ZipFile = zipFile
+		} else {
+			input.Code.S3Bucket = aws.String(s3Bucket)
+			input.Code.S3Key = aws.String(s3Key)
+		}
 	} else {
 		input.Code.S3Bucket = aws.String(d.Get("s3_bucket").(string))
 		input.Code.S3Key = aws.String(d.Get("s3_key").(string))
@@ -520,6 +606,10 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Layers = flex.ExpandStringValueList(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("logging_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LoggingConfig = expandLoggingConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("snap_start"); ok {
 		input.SnapStart = expandSnapStart(v.([]interface{}))
 	}
@@ -638,6 +728,9 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := d.Set("layers", flattenLayers(function.Layers)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting layers: %s", err)
 	}
+	if err := d.Set("logging_config", flattenLoggingConfig(function.LoggingConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting logging_config: %s", err)
+	}
 	d.Set("memory_size", function.MemorySize)
 	d.Set("package_type", function.PackageType)
 	if output.Concurrency != nil {
@@ -821,6 +914,10 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.Layers = flex.ExpandStringValueList(d.Get("layers").([]interface{}))
 		}
 
+		if d.HasChange("logging_config") {
+			input.LoggingConfig = expandLoggingConfig(d.Get("logging_config").([]interface{}))
+		}
+
 		if d.HasChange("memory_size") {
 			input.MemorySize = aws.Int32(int32(d.Get("memory_size").(int)))
 		}
@@ -908,6 +1005,19 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.ZipFile = zipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
+		} else if _, ok := d.GetOk("source"); ok {
+			zipFile, s3Bucket, s3Key, err := functionSourceCode(ctx, d, meta)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), err)
+			}
+
+			if zipFile != nil {
+				input.ZipFile = zipFile
+			} else {
+				input.S3Bucket = aws.String(s3Bucket)
+				input.S3Key = aws.String(s3Key)
+			}
 		} else {
 			input.S3Bucket = aws.String(d.Get("s3_bucket").(string))
 			input.S3Key = aws.String(d.Get("s3_key").(string))
@@ -1247,6 +1357,7 @@ func needsFunctionConfigUpdate(d verify.ResourceDiffer) bool {
 		d.HasChange("timeout") ||
 		d.HasChange("kms_key_arn") ||
 		d.HasChange("layers") ||
+		d.HasChange("logging_config") ||
 		d.HasChange("dead_letter_config") ||
 		d.HasChange("snap_start") ||
 		d.HasChange("tracing_config") ||
@@ -1389,6 +1500,44 @@ func flattenEphemeralStorage(response *types.EphemeralStorage) []map[string]inte
 	return []map[string]interface{}{m}
 }
 
+func expandLoggingConfig(tfList []interface{}) *types.LoggingConfig {
+	loggingConfig := &types.LoggingConfig{}
+	if len(tfList) == 1 && tfList[0] != nil {
+		tfMap := tfList[0].(map[string]interface{})
+
+		if v, ok := tfMap["application_log_level"].(string); ok && v != "" {
+			loggingConfig.ApplicationLogLevel = types.ApplicationLogLevel(v)
+		}
+
+		if v, ok := tfMap["log_format"].(string); ok && v != "" {
+			loggingConfig.LogFormat = types.LogFormat(v)
+		}
+
+		if v, ok := tfMap["log_group"].(string); ok && v != "" {
+			loggingConfig.LogGroup = aws.String(v)
+		}
+
+		if v, ok := tfMap["system_log_level"].(string); ok && v != "" {
+			loggingConfig.SystemLogLevel = types.SystemLogLevel(v)
+		}
+	}
+	return loggingConfig
+}
+
+func flattenLoggingConfig(apiObject *types.LoggingConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+	m := map[string]interface{}{
+		"application_log_level": string(apiObject.ApplicationLogLevel),
+		"log_format":            string(apiObject.LogFormat),
+		"log_group":             aws.ToString(apiObject.LogGroup),
+		"system_log_level":      string(apiObject.SystemLogLevel),
+	}
+
+	return []interface{}{m}
+}
+
 func expandSnapStart(tfList []interface{}) *types.SnapStart {
 	snapStart := &types.SnapStart{ApplyOn: types.SnapStartApplyOnNone}
 	if len(tfList) == 1 && tfList[0] != nil {
@@ -0,0 +1,632 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// Maximum size of a deployment package uploaded directly in the CreateFunction or UpdateFunctionCode request.
+	// Larger packages must be uploaded to S3 first.
+	functionZipFileMaxSize = 50 * 1024 * 1024
+)
+
+// Fixed modification time for all deployment package entries so that the package only depends on file contents.
+// The ZIP format can't represent times before 1980.
+var functionSourceModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+type functionSource struct {
+	directory          string
+	excludes           []string
+	dependencyManifest string
+	runtime            string
+	s3Bucket           string
+	s3KeyPrefix        string
+}
+
+func expandFunctionSource(tfList []interface{}, runtime string) *functionSource {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &functionSource{
+		directory: tfMap["directory"].(string),
+		runtime:   runtime,
+	}
+
+	if v, ok := tfMap["dependency_manifest"].(string); ok {
+		apiObject.dependencyManifest = v
+	}
+
+	if v, ok := tfMap["excludes"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.excludes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["s3_bucket"].(string); ok {
+		apiObject.s3Bucket = v
+	}
+
+	if v, ok := tfMap["s3_key_prefix"].(string); ok {
+		apiObject.s3KeyPrefix = v
+	}
+
+	return apiObject
+}
+
+func (s *functionSource) expandDirectory() (string, error) {
+	directory, err := homedir.Expand(s.directory)
+
+	if err != nil {
+		return "", err
+	}
+
+	if fi, err := os.Stat(directory); err != nil {
+		return "", err
+	} else if !fi.IsDir() {
+		return "", fmt.Errorf("%s is not a directory", directory)
+	}
+
+	return directory, nil
+}
+
+// functionPackage is a deployment package built from a source directory.
+type functionPackage struct {
+	path     string
+	sha256   string // Base64-encoded, as returned by Lambda in CodeSha256.
+	size     int64
+	tempDirs []string
+}
+
+func (p *functionPackage) close() {
+	for _, v := range p.tempDirs {
+		os.RemoveAll(v)
+	}
+}
+
+// build creates a deterministic ZIP deployment package from the source directory.
+// Entries are added in lexical order with a fixed modification time and normalized permissions,
+// so the package, and therefore its hash, only changes when file contents or names change.
+func (s *functionSource) build(ctx context.Context) (*functionPackage, error) {
+	directory, err := s.expandDirectory()
+
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &functionPackage{}
+	files := make(map[string]string) // Archive name -> file path.
+
+	if err := addFunctionSourceFiles(files, directory, "", s.excludes); err != nil {
+		pkg.close()
+		return nil, err
+	}
+
+	if s.dependencyManifest != "" {
+		dir, prefix, err := s.installDependencies(ctx, directory)
+
+		if dir != "" {
+			pkg.tempDirs = append(pkg.tempDirs, dir)
+		}
+
+		if err != nil {
+			pkg.close()
+			return nil, err
+		}
+
+		if err := addFunctionSourceFiles(files, filepath.Join(dir, filepath.FromSlash(prefix)), prefix, nil); err != nil {
+			pkg.close()
+			return nil, err
+		}
+	}
+
+	outputDir, err := os.MkdirTemp("", "terraform-provider-aws-lambda-")
+
+	if err != nil {
+		pkg.close()
+		return nil, err
+	}
+
+	pkg.tempDirs = append(pkg.tempDirs, outputDir)
+	pkg.path = filepath.Join(outputDir, "package.zip")
+
+	if err := writeFunctionPackage(pkg.path, files); err != nil {
+		pkg.close()
+		return nil, err
+	}
+
+	f, err := os.Open(pkg.path)
+
+	if err != nil {
+		pkg.close()
+		return nil, err
+	}
+
+	defer f.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+
+	if err != nil {
+		pkg.close()
+		return nil, err
+	}
+
+	pkg.sha256 = base64.StdEncoding.EncodeToString(h.Sum(nil))
+	pkg.size = n
+
+	return pkg, nil
+}
+
+// dependencyFiles returns the paths of the dependency manifest and its lock file.
+// Dependencies are only installed from a lock file so that the files hashed at plan time pin the installed packages:
+// for nodejs* runtimes package-lock.json must exist beside package.json, and for python* runtimes
+// every requirement in the requirements file must be pinned with == and --hash.
+func (s *functionSource) dependencyFiles(directory string) ([]string, error) {
+	manifest := filepath.Join(directory, filepath.FromSlash(s.dependencyManifest))
+
+	switch {
+	case strings.HasPrefix(s.runtime, "python"):
+		b, err := os.ReadFile(manifest)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading dependency manifest: %w", err)
+		}
+
+		if err := checkPinnedRequirements(string(b)); err != nil {
+			return nil, fmt.Errorf("dependency manifest %s: %w", s.dependencyManifest, err)
+		}
+
+		return []string{manifest}, nil
+	case strings.HasPrefix(s.runtime, "nodejs"):
+		lockFile := filepath.Join(filepath.Dir(manifest), "package-lock.json")
+
+		for _, v := range []string{manifest, lockFile} {
+			if _, err := os.Stat(v); err != nil {
+				return nil, fmt.Errorf("reading dependency manifest: %w", err)
+			}
+		}
+
+		return []string{manifest, lockFile}, nil
+	default:
+		return nil, fmt.Errorf("dependency_manifest is not supported for runtime %q", s.runtime)
+	}
+}
+
+// checkPinnedRequirements returns an error if any requirement in the pip requirements file isn't pinned to a version and hash.
+func checkPinnedRequirements(requirements string) error {
+	// Join continuation lines.
+	requirements = strings.ReplaceAll(requirements, "\\\r\n", " ")
+	requirements = strings.ReplaceAll(requirements, "\\\n", " ")
+
+	for _, line := range strings.Split(requirements, "\n") {
+		if i := strings.Index(line, "#"); i >= 0 && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t') {
+			line = line[:i]
+		}
+
+		line = strings.TrimSpace(line)
+
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "-") {
+			// Nested requirement files and editable installs aren't covered by the hash of this file.
+			for _, option := range []string{"-r", "--requirement", "-c", "--constraint", "-e", "--editable"} {
+				if line == option || strings.HasPrefix(line, option+" ") || strings.HasPrefix(line, option+"=") {
+					return fmt.Errorf("option %q is not supported; list all requirements in a single file", option)
+				}
+			}
+
+			continue
+		}
+
+		if !strings.Contains(line, "==") || !strings.Contains(line, "--hash=") {
+			return fmt.Errorf("requirement %q must be pinned with == and --hash", strings.Fields(line)[0])
+		}
+	}
+
+	return nil
+}
+
+// installDependencies installs the dependencies listed in the manifest into a temporary directory using the runtime's package manager.
+// It returns the directory and the path within it, relative to the package root, at which dependencies must be placed.
+func (s *functionSource) installDependencies(ctx context.Context, directory string) (string, string, error) {
+	files, err := s.dependencyFiles(directory)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	dir, err := os.MkdirTemp("", "terraform-provider-aws-lambda-dependencies-")
+
+	if err != nil {
+		return "", "", err
+	}
+
+	var (
+		cmd    *exec.Cmd
+		prefix string
+	)
+
+	if strings.HasPrefix(s.runtime, "python") {
+		cmd = exec.CommandContext(ctx, "pip3", "install", "--requirement", files[0], "--require-hashes", "--target", dir, "--no-compile", "--no-cache-dir", "--disable-pip-version-check", "--quiet")
+	} else {
+		// npm installs into node_modules next to package.json, so work on a copy of the manifest and its lock file.
+		for _, v := range files {
+			b, err := os.ReadFile(v)
+
+			if err != nil {
+				return dir, "", err
+			}
+
+			if err := os.WriteFile(filepath.Join(dir, filepath.Base(v)), b, 0600); err != nil {
+				return dir, "", err
+			}
+		}
+
+		cmd = exec.CommandContext(ctx, "npm", "ci", "--omit=dev", "--no-audit", "--no-fund", "--ignore-scripts")
+		cmd.Dir = dir
+		prefix = "node_modules"
+	}
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return dir, "", fmt.Errorf("installing dependencies from %s: %w\n%s", s.dependencyManifest, err, output)
+	}
+
+	if prefix != "" {
+		if err := os.MkdirAll(filepath.Join(dir, prefix), 0700); err != nil {
+			return dir, "", err
+		}
+	}
+
+	return dir, prefix, nil
+}
+
+// contentHash returns a base64-encoded SHA256 hash of the files that make up the deployment package:
+// the source files and, if dependencies are installed, the runtime and the dependency manifest and lock file.
+// Unlike the package itself, it can be computed without installing dependencies.
+func (s *functionSource) contentHash() (string, error) {
+	directory, err := s.expandDirectory()
+
+	if err != nil {
+		return "", err
+	}
+
+	files := make(map[string]string) // Archive name -> file path.
+
+	if err := addFunctionSourceFiles(files, directory, "", s.excludes); err != nil {
+		return "", err
+	}
+
+	names := make([]string, 0, len(files))
+	for k := range files {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+
+	for _, name := range names {
+		if err := hashFunctionSourceFile(h, name, files[name]); err != nil {
+			return "", err
+		}
+	}
+
+	if s.dependencyManifest != "" {
+		dependencyFiles, err := s.dependencyFiles(directory)
+
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(h, "dependencies\x00%s\x00", s.runtime)
+
+		for _, v := range dependencyFiles {
+			if err := hashFunctionSourceFile(h, filepath.Base(v), v); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+func hashFunctionSourceFile(w io.Writer, name, p string) error {
+	f, err := os.Open(p)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	fi, err := f.Stat()
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "%s\x00%o\x00%d\x00", name, functionPackageFileMode(fi), fi.Size())
+	_, err = io.Copy(w, f)
+
+	return err
+}
+
+// addFunctionSourceFiles walks root and adds every regular file not matching excludes to files, keyed by its archive name.
+func addFunctionSourceFiles(files map[string]string, root, prefix string, excludes []string) error {
+	return filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, p)
+
+		if err != nil {
+			return err
+		}
+
+		if rel == "." {
+			return nil
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if functionSourceExcluded(excludes, rel) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		if d.Type()&fs.ModeSymlink != 0 {
+			fi, err := os.Stat(p)
+
+			if err != nil {
+				return err
+			}
+
+			if fi.IsDir() {
+				return fmt.Errorf("%s: symbolic links to directories are not supported", p)
+			}
+		} else if !d.Type().IsRegular() {
+			return nil
+		}
+
+		files[path.Join(prefix, rel)] = p
+
+		return nil
+	})
+}
+
+// functionSourceExcluded returns whether the slash-separated relative path matches any of the exclude patterns.
+// Patterns use path.Match syntax and are matched against the relative path; patterns without a slash are also matched against the base name.
+func functionSourceExcluded(excludes []string, rel string) bool {
+	for _, pattern := range excludes {
+		pattern = strings.TrimSuffix(pattern, "/")
+
+		if ok, _ := path.Match(pattern, rel); ok {
+			return true
+		}
+
+		if !strings.Contains(pattern, "/") {
+			if ok, _ := path.Match(pattern, path.Base(rel)); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func writeFunctionPackage(name string, files map[string]string) error {
+	f, err := os.Create(name)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	names := make([]string, 0, len(files))
+	for k := range files {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	w := zip.NewWriter(f)
+
+	for _, name := range names {
+		if err := writeFunctionPackageFile(w, name, files[name]); err != nil {
+			return err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+func writeFunctionPackageFile(w *zip.Writer, name, p string) error {
+	fi, err := os.Stat(p)
+
+	if err != nil {
+		return err
+	}
+
+	header := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: functionSourceModified,
+	}
+	header.SetMode(functionPackageFileMode(fi))
+
+	fw, err := w.CreateHeader(header)
+
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(p)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	_, err = io.Copy(fw, f)
+
+	return err
+}
+
+// functionPackageFileMode keeps only the executable bit so that the package doesn't depend on the local umask.
+func functionPackageFileMode(fi fs.FileInfo) fs.FileMode {
+	if fi.Mode()&0111 != 0 {
+		return 0755
+	}
+
+	return 0644
+}
+
+// upload uploads the package to S3 under a key derived from its hash, returning the key.
+func (s *functionSource) upload(ctx context.Context, conn *s3.S3, functionName string, pkg *functionPackage) (string, error) {
+	f, err := os.Open(pkg.path)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	// Use the URL-safe hash encoding so that the key doesn't contain '/'.
+	hash, _ := base64.StdEncoding.DecodeString(pkg.sha256)
+	key := fmt.Sprintf("%s%s/%s.zip", s.s3KeyPrefix, functionName, base64.RawURLEncoding.EncodeToString(hash))
+
+	_, err = conn.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Body:          f,
+		Bucket:        aws_sdkv1.String(s.s3Bucket),
+		ContentLength: aws_sdkv1.Int64(pkg.size),
+		Key:           aws_sdkv1.String(key),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("uploading deployment package to S3 (%s/%s): %w", s.s3Bucket, key, err)
+	}
+
+	return key, nil
+}
+
+// functionSourceCode builds the deployment package for the source block and returns its code location,
+// either inline or, for packages above the direct upload limit, uploaded to S3.
+// The source files must match the source_content_hash computed at plan time.
+func functionSourceCode(ctx context.Context, d *schema.ResourceData, meta interface{}) (zipFile []byte, s3Bucket, s3Key string, err error) {
+	source := expandFunctionSource(d.Get("source").([]interface{}), d.Get("runtime").(string))
+	functionName := d.Get("function_name").(string)
+
+	// Grab an exclusive lock so that we're only building one function package at a time.
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	contentHash, err := source.contentHash()
+
+	if err != nil {
+		return nil, "", "", fmt.Errorf("hashing source directory %s: %w", source.directory, err)
+	}
+
+	if v := d.Get("source_content_hash").(string); v != "" && v != contentHash {
+		return nil, "", "", fmt.Errorf("source directory %s has hash %s, expected %s; the source directory changed after the plan was created", source.directory, contentHash, v)
+	}
+
+	pkg, err := source.build(ctx)
+
+	if err != nil {
+		return nil, "", "", fmt.Errorf("building deployment package from %s: %w", source.directory, err)
+	}
+
+	defer pkg.close()
+
+	if pkg.size <= functionZipFileMaxSize {
+		zipFile, err := os.ReadFile(pkg.path)
+
+		return zipFile, "", "", err
+	}
+
+	if source.s3Bucket == "" {
+		return nil, "", "", fmt.Errorf("deployment package size (%d bytes) exceeds the direct upload limit (%d bytes); set source.s3_bucket", pkg.size, functionZipFileMaxSize)
+	}
+
+	key, err := source.upload(ctx, meta.(*conns.AWSClient).S3Conn(ctx), functionName, pkg)
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return nil, source.s3Bucket, key, nil
+}
+
+// computeSourceCodeHashFromSource sets source_content_hash to the hash of the files the deployment package is built from,
+// so that changes to the source directory show up as a code update in the plan.
+// The package itself, and therefore source_code_hash, is only built during apply because installing dependencies
+// runs the runtime's package manager.
+func computeSourceCodeHashFromSource(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	source := expandFunctionSource(d.Get("source").([]interface{}), d.Get("runtime").(string))
+
+	if source == nil {
+		return nil
+	}
+
+	// The directory may be created by another resource during apply.
+	if !d.NewValueKnown("source") {
+		if err := d.SetNewComputed("source_content_hash"); err != nil {
+			return err
+		}
+
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	contentHash, err := source.contentHash()
+
+	if err != nil {
+		return fmt.Errorf("hashing source directory %s: %w", source.directory, err)
+	}
+
+	if d.Get("source_content_hash").(string) != contentHash {
+		if err := d.SetNew("source_content_hash", contentHash); err != nil {
+			return err
+		}
+
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	return nil
+}
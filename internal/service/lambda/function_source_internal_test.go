@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFunctionSourceExcluded(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Excludes []string
+		Path     string
+		Expected bool
+	}{
+		{Excludes: nil, Path: "index.js", Expected: false},
+		{Excludes: []string{"*.pyc"}, Path: "app/handler.pyc", Expected: true},
+		{Excludes: []string{"*.pyc"}, Path: "app/handler.py", Expected: false},
+		{Excludes: []string{"tests"}, Path: "tests", Expected: true},
+		{Excludes: []string{"tests/"}, Path: "tests", Expected: true},
+		{Excludes: []string{"app/*.md"}, Path: "app/README.md", Expected: true},
+		{Excludes: []string{"app/*.md"}, Path: "README.md", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := functionSourceExcluded(testCase.Excludes, testCase.Path); got != testCase.Expected {
+			t.Errorf("functionSourceExcluded(%v, %q) = %t, expected %t", testCase.Excludes, testCase.Path, got, testCase.Expected)
+		}
+	}
+}
+
+func TestFunctionSourceBuild(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	for name, content := range map[string]string{
+		"index.js":             "exports.handler = async () => 'ok';\n",
+		"lib/util.js":          "module.exports = {};\n",
+		"lib/util.test.js":     "test();\n",
+		"tests/fixture.json":   "{}\n",
+		"node_modules/.marker": "\n",
+	} {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	source := &functionSource{
+		directory: dir,
+		excludes:  []string{"*.test.js", "tests", "node_modules"},
+	}
+
+	pkg1, err := source.build(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer pkg1.close()
+
+	r, err := zip.OpenReader(pkg1.path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer r.Close()
+
+	var names []string
+	for _, f := range r.File {
+		names = append(names, f.Name)
+
+		if !f.Modified.Equal(functionSourceModified) {
+			t.Errorf("%s: got modification time %s, expected %s", f.Name, f.Modified, functionSourceModified)
+		}
+	}
+
+	if expected := []string{"index.js", "lib/util.js"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("got entries %v, expected %v", names, expected)
+	}
+
+	// Touching files must not change the package.
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "index.js"), later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	pkg2, err := source.build(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer pkg2.close()
+
+	if pkg1.sha256 != pkg2.sha256 {
+		t.Errorf("package hash changed after touching a file: %s != %s", pkg1.sha256, pkg2.sha256)
+	}
+
+	// Changing contents must change the package.
+	if err := os.WriteFile(filepath.Join(dir, "index.js"), []byte("exports.handler = async () => 'changed';\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	pkg3, err := source.build(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer pkg3.close()
+
+	if pkg1.sha256 == pkg3.sha256 {
+		t.Errorf("package hash unchanged after changing file contents")
+	}
+}
+
+func TestCheckPinnedRequirements(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Requirements string
+		ExpectError  bool
+	}{
+		"empty": {
+			Requirements: "# No dependencies.\n",
+		},
+		"pinned with hashes": {
+			Requirements: "--index-url https://pypi.org/simple\nrequests==2.31.0 \\\n    --hash=sha256:58cd2187c01e70e6e26505bca751777aa9f2ee0b7f4300988b709f44e013003f\n",
+		},
+		"unpinned": {
+			Requirements: "requests>=2.31.0 --hash=sha256:58cd2187c01e70e6e26505bca751777aa9f2ee0b7f4300988b709f44e013003f\n",
+			ExpectError:  true,
+		},
+		"without hash": {
+			Requirements: "requests==2.31.0 # HTTP client\n",
+			ExpectError:  true,
+		},
+		"nested requirements file": {
+			Requirements: "-r base.txt\n",
+			ExpectError:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkPinnedRequirements(testCase.Requirements)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestFunctionSourceContentHash(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	writeFile := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeFile("index.js", "exports.handler = async () => 'ok';\n")
+	writeFile("package.json", `{"dependencies":{"uuid":"^9.0.0"}}`)
+
+	source := &functionSource{
+		directory:          dir,
+		dependencyManifest: "package.json",
+		runtime:            "nodejs18.x",
+	}
+
+	// A lock file is required to install dependencies.
+	if _, err := source.contentHash(); err == nil {
+		t.Fatal("expected error for missing package-lock.json")
+	}
+
+	writeFile("package-lock.json", `{"packages":{"node_modules/uuid":{"version":"9.0.0"}}}`)
+
+	hash1, err := source.contentHash()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Touching files must not change the hash.
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "index.js"), later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	hash2, err := source.contentHash()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hash1 != hash2 {
+		t.Errorf("hash changed after touching a file: %s != %s", hash1, hash2)
+	}
+
+	// Changing the locked dependency versions must change the hash, even if the lock file is excluded from the package.
+	source.excludes = []string{"package-lock.json"}
+	writeFile("package-lock.json", `{"packages":{"node_modules/uuid":{"version":"9.0.1"}}}`)
+
+	hash3, err := source.contentHash()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hash1 == hash3 {
+		t.Errorf("hash unchanged after changing the lock file")
+	}
+}
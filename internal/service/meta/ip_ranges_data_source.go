@@ -9,13 +9,19 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/netip"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
 
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -45,6 +51,9 @@ func (d *dataSourceIPRanges) Metadata(_ context.Context, request datasource.Meta
 func (d *dataSourceIPRanges) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"aggregate": schema.BoolAttribute{
+				Optional: true,
+			},
 			"cidr_blocks": schema.ListAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
@@ -60,6 +69,16 @@ func (d *dataSourceIPRanges) Schema(ctx context.Context, req datasource.SchemaRe
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"max_entries": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"network_border_groups": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 			"regions": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -68,6 +87,12 @@ func (d *dataSourceIPRanges) Schema(ctx context.Context, req datasource.SchemaRe
 				ElementType: types.StringType,
 				Required:    true,
 			},
+			"source_file": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("url")),
+				},
+			},
 			"sync_token": schema.Int64Attribute{
 				Computed: true,
 			},
@@ -89,21 +114,39 @@ func (d *dataSourceIPRanges) Read(ctx context.Context, request datasource.ReadRe
 		return
 	}
 
-	var url string
+	var bytes []byte
+
+	if !data.SourceFile.IsNull() {
+		path := data.SourceFile.ValueString()
+		v, err := os.ReadFile(path)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading IP ranges file (%s)", path), err.Error())
+
+			return
+		}
 
-	if data.URL.IsNull() {
-		// Data sources make no use of AttributePlanModifiers to set default values.
-		url = "https://ip-ranges.amazonaws.com/ip-ranges.json"
+		bytes = v
 	} else {
-		url = data.URL.ValueString()
-	}
+		var url string
 
-	bytes, err := readAll(ctx, url)
+		if data.URL.IsNull() {
+			// Data sources make no use of AttributePlanModifiers to set default values.
+			url = "https://ip-ranges.amazonaws.com/ip-ranges.json"
+		} else {
+			url = data.URL.ValueString()
+		}
 
-	if err != nil {
-		response.Diagnostics.AddError("downloading IP ranges", err.Error())
+		v, err := readAll(ctx, url)
 
-		return
+		if err != nil {
+			response.Diagnostics.AddError("downloading IP ranges", err.Error())
+
+			return
+		}
+
+		bytes = v
+		data.URL = types.StringValue(url)
 	}
 
 	ipRanges := new(ipRanges)
@@ -122,19 +165,21 @@ func (d *dataSourceIPRanges) Read(ctx context.Context, request datasource.ReadRe
 		return
 	}
 
+	networkBorderGroups := tfslices.ApplyToAll(flex.ExpandFrameworkStringValueSet(ctx, data.NetworkBorderGroups), strings.ToLower)
 	regions := tfslices.ApplyToAll(flex.ExpandFrameworkStringValueSet(ctx, data.Regions), strings.ToLower)
 	services := tfslices.ApplyToAll(flex.ExpandFrameworkStringValueSet(ctx, data.Services), strings.ToLower)
-	matchFilter := func(region, service string) bool {
+	matchFilter := func(networkBorderGroup, region, service string) bool {
+		matchNetworkBorderGroup := len(networkBorderGroups) == 0 || slices.Contains(networkBorderGroups, strings.ToLower(networkBorderGroup))
 		matchRegion := len(regions) == 0 || slices.Contains(regions, strings.ToLower(region))
 		matchService := slices.Contains(services, strings.ToLower(service))
 
-		return matchRegion && matchService
+		return matchNetworkBorderGroup && matchRegion && matchService
 	}
 
 	var ipv4Prefixes []string
 
 	for _, v := range ipRanges.IPv4Prefixes {
-		if matchFilter(v.Region, v.Service) {
+		if matchFilter(v.NetworkBorderGroup, v.Region, v.Service) {
 			ipv4Prefixes = append(ipv4Prefixes, v.Prefix)
 		}
 	}
@@ -144,32 +189,65 @@ func (d *dataSourceIPRanges) Read(ctx context.Context, request datasource.ReadRe
 	var ipv6Prefixes []string
 
 	for _, v := range ipRanges.IPv6Prefixes {
-		if matchFilter(v.Region, v.Service) {
+		if matchFilter(v.NetworkBorderGroup, v.Region, v.Service) {
 			ipv6Prefixes = append(ipv6Prefixes, v.Prefix)
 		}
 	}
 
 	sort.Strings(ipv6Prefixes)
 
+	if data.Aggregate.ValueBool() {
+		if ipv4Prefixes, err = aggregateCIDRBlocks(ipv4Prefixes); err != nil {
+			response.Diagnostics.AddError("aggregating CIDR blocks", err.Error())
+
+			return
+		}
+
+		if ipv6Prefixes, err = aggregateCIDRBlocks(ipv6Prefixes); err != nil {
+			response.Diagnostics.AddError("aggregating IPv6 CIDR blocks", err.Error())
+
+			return
+		}
+	}
+
+	if !data.MaxEntries.IsNull() {
+		maxEntries := int(data.MaxEntries.ValueInt64())
+
+		if n := len(ipv4Prefixes); n > maxEntries {
+			response.Diagnostics.AddError("too many CIDR blocks", fmt.Sprintf("filtered IP ranges contain %d CIDR blocks, more than max_entries (%d)", n, maxEntries))
+
+			return
+		}
+
+		if n := len(ipv6Prefixes); n > maxEntries {
+			response.Diagnostics.AddError("too many IPv6 CIDR blocks", fmt.Sprintf("filtered IP ranges contain %d IPv6 CIDR blocks, more than max_entries (%d)", n, maxEntries))
+
+			return
+		}
+	}
+
 	data.CreateDate = types.StringValue(ipRanges.CreateDate)
 	data.ID = types.StringValue(ipRanges.SyncToken)
 	data.IPv4CIDRBlocks = flex.FlattenFrameworkStringValueListLegacy(ctx, ipv4Prefixes)
 	data.IPv6CIDRBlocks = flex.FlattenFrameworkStringValueListLegacy(ctx, ipv6Prefixes)
 	data.SyncToken = types.Int64Value(int64(syncToken))
-	data.URL = types.StringValue(url)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
 type dataSourceIPRangesData struct {
-	CreateDate     types.String `tfsdk:"create_date"`
-	ID             types.String `tfsdk:"id"`
-	IPv4CIDRBlocks types.List   `tfsdk:"cidr_blocks"`
-	IPv6CIDRBlocks types.List   `tfsdk:"ipv6_cidr_blocks"`
-	Regions        types.Set    `tfsdk:"regions"`
-	Services       types.Set    `tfsdk:"services"`
-	SyncToken      types.Int64  `tfsdk:"sync_token"`
-	URL            types.String `tfsdk:"url"`
+	Aggregate           types.Bool   `tfsdk:"aggregate"`
+	CreateDate          types.String `tfsdk:"create_date"`
+	ID                  types.String `tfsdk:"id"`
+	IPv4CIDRBlocks      types.List   `tfsdk:"cidr_blocks"`
+	IPv6CIDRBlocks      types.List   `tfsdk:"ipv6_cidr_blocks"`
+	MaxEntries          types.Int64  `tfsdk:"max_entries"`
+	NetworkBorderGroups types.Set    `tfsdk:"network_border_groups"`
+	Regions             types.Set    `tfsdk:"regions"`
+	Services            types.Set    `tfsdk:"services"`
+	SourceFile          types.String `tfsdk:"source_file"`
+	SyncToken           types.Int64  `tfsdk:"sync_token"`
+	URL                 types.String `tfsdk:"url"`
 }
 
 func readAll(ctx context.Context, url string) ([]byte, error) {
@@ -204,13 +282,84 @@ type ipRanges struct {
 }
 
 type ipv4Prefix struct {
-	Prefix  string `json:"ip_prefix"`
-	Region  string
-	Service string
+	NetworkBorderGroup string `json:"network_border_group"`
+	Prefix             string `json:"ip_prefix"`
+	Region             string
+	Service            string
 }
 
 type ipv6Prefix struct {
-	Prefix  string `json:"ipv6_prefix"`
-	Region  string
-	Service string
+	NetworkBorderGroup string `json:"network_border_group"`
+	Prefix             string `json:"ipv6_prefix"`
+	Region             string
+	Service            string
+}
+
+// aggregateCIDRBlocks returns the smallest set of CIDR blocks covering the same addresses as the input,
+// sorted by address. Duplicates and CIDR blocks contained in others are dropped and adjacent sibling
+// CIDR blocks are merged.
+func aggregateCIDRBlocks(cidrBlocks []string) ([]string, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrBlocks))
+
+	for _, v := range cidrBlocks {
+		prefix, err := netip.ParsePrefix(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	sort.Slice(prefixes, func(i, j int) bool {
+		if c := prefixes[i].Addr().Compare(prefixes[j].Addr()); c != 0 {
+			return c < 0
+		}
+
+		return prefixes[i].Bits() < prefixes[j].Bits()
+	})
+
+	var aggregated []netip.Prefix
+
+	for _, prefix := range prefixes {
+		if n := len(aggregated); n > 0 && aggregated[n-1].Overlaps(prefix) {
+			// Sorted input means the previous prefix starts at or before this one, so it contains it.
+			continue
+		}
+
+		aggregated = append(aggregated, prefix)
+
+		for len(aggregated) >= 2 {
+			n := len(aggregated)
+			parent, ok := siblingPrefixesParent(aggregated[n-2], aggregated[n-1])
+
+			if !ok {
+				break
+			}
+
+			aggregated = append(aggregated[:n-2], parent)
+		}
+	}
+
+	output := make([]string, 0, len(aggregated))
+	for _, v := range aggregated {
+		output = append(output, v.String())
+	}
+
+	return output, nil
+}
+
+// siblingPrefixesParent returns the enclosing prefix if a and b are the two halves of it.
+func siblingPrefixesParent(a, b netip.Prefix) (netip.Prefix, bool) {
+	if a.Bits() != b.Bits() || a.Bits() == 0 {
+		return netip.Prefix{}, false
+	}
+
+	parent, err := a.Addr().Prefix(a.Bits() - 1)
+
+	if err != nil || parent.Addr() != a.Addr() || !parent.Contains(b.Addr()) {
+		return netip.Prefix{}, false
+	}
+
+	return parent, true
 }
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAggregateCIDRBlocks(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input []string
+		want  []string
+	}{
+		"empty": {
+			want: []string{},
+		},
+		"duplicates": {
+			input: []string{"10.0.1.0/24", "10.0.1.0/24"},
+			want:  []string{"10.0.1.0/24"},
+		},
+		"siblings": {
+			input: []string{"10.0.1.0/24", "10.0.0.0/24"},
+			want:  []string{"10.0.0.0/23"},
+		},
+		"not siblings": {
+			input: []string{"10.0.2.0/24", "10.0.1.0/24"},
+			want:  []string{"10.0.1.0/24", "10.0.2.0/24"},
+		},
+		"cascading merge": {
+			input: []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/23"},
+			want:  []string{"10.0.0.0/22"},
+		},
+		"contained": {
+			input: []string{"10.0.1.0/24", "10.0.0.0/16", "10.1.0.0/24"},
+			want:  []string{"10.0.0.0/16", "10.1.0.0/24"},
+		},
+		"sorted by address": {
+			input: []string{"9.0.0.0/24", "10.0.0.0/24"},
+			want:  []string{"9.0.0.0/24", "10.0.0.0/24"},
+		},
+		"IPv6": {
+			input: []string{"2600:1f00:80::/41", "2600:1f00::/41"},
+			want:  []string{"2600:1f00::/40"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := aggregateCIDRBlocks(testCase.input)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+
+	if _, err := aggregateCIDRBlocks([]string{"10.0.0.0"}); err == nil {
+		t.Error("expected error for invalid CIDR block")
+	}
+}
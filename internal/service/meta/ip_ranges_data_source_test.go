@@ -102,6 +102,44 @@ func TestAccMetaIPRangesDataSource_uppercase(t *testing.T) {
 	})
 }
 
+func TestAccMetaIPRangesDataSource_sourceFile(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ip_ranges.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPRangesDataSourceConfig_sourceFile(false, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "1700000000"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks.0", "10.0.0.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks.1", "10.0.0.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks.2", "10.0.1.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "create_date", "2023-11-14-22-13-20"),
+					resource.TestCheckResourceAttr(dataSourceName, "ipv6_cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "sync_token", "1700000000"),
+				),
+			},
+			{
+				Config: testAccIPRangesDataSourceConfig_sourceFile(true, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks.0", "10.0.0.0/23"),
+					resource.TestCheckResourceAttr(dataSourceName, "ipv6_cidr_blocks.#", "1"),
+				),
+			},
+			{
+				Config:      testAccIPRangesDataSourceConfig_sourceFile(false, 1),
+				ExpectError: regexp.MustCompile(`contain 3 CIDR blocks, more than max_entries \(1\)`),
+			},
+		},
+	})
+}
+
 func testAccIPRangesCheckAttributes(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		r := s.RootModule().Resources[n]
@@ -233,3 +271,15 @@ data "aws_ip_ranges" "test" {
   services = ["AMAZON"]
 }
 `
+
+func testAccIPRangesDataSourceConfig_sourceFile(aggregate bool, maxEntries int) string {
+	return fmt.Sprintf(`
+data "aws_ip_ranges" "test" {
+  source_file           = "test-fixtures/ip-ranges.json"
+  network_border_groups = ["us-east-1"]
+  services              = ["amazon", "ec2"]
+  aggregate             = %[1]t
+  max_entries           = %[2]d
+}
+`, aggregate, maxEntries)
+}
@@ -77,6 +77,12 @@ func TestAccOrganizations_serial(t *testing.T) {
 		"PolicyDataSource": {
 			"UnattachedPolicy": testAccPolicyDataSource_UnattachedPolicy,
 		},
+		"PolicySimulationDataSource": {
+			"basic":                     testAccPolicySimulationDataSource_basic,
+			"PolicyOverride":            testAccPolicySimulationDataSource_policyOverride,
+			"PolicyOverrideNotAttached": testAccPolicySimulationDataSource_policyOverrideNotAttached,
+			"AdditionalPolicy":          testAccPolicySimulationDataSource_additionalPolicy,
+		},
 		"ResourcePolicy": {
 			"basic":      testAccResourcePolicy_basic,
 			"disappears": testAccResourcePolicy_disappears,
@@ -0,0 +1,507 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+const (
+	// Resource control policies are not yet modeled by the AWS SDK.
+	policyTypeResourceControlPolicy = "RESOURCE_CONTROL_POLICY"
+
+	policySimulationDecisionAllowed      = "allowed"
+	policySimulationDecisionExplicitDeny = "explicitDeny"
+	policySimulationDecisionImplicitDeny = "implicitDeny"
+
+	// Organizations supports a maximum OU nesting depth of five levels below the root.
+	policySimulationMaxPathLength = 7
+)
+
+// @SDKDataSource("aws_organizations_policy_simulation")
+func DataSourcePolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"additional_policy": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"target_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"path": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"policy_override": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"policy_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      organizations.PolicyTypeServiceControlPolicy,
+				ValidateFunc: validation.StringInSlice([]string{organizations.PolicyTypeServiceControlPolicy, policyTypeResourceControlPolicy}, false),
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"denied_at_target_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"matched_policy_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"target_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourcePolicySimulationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
+
+	targetID := d.Get("target_id").(string)
+	policyType := d.Get("policy_type").(string)
+
+	path, err := findPolicyTargetPath(ctx, conn, targetID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Organizations target (%s) path: %s", targetID, err)
+	}
+
+	overrides := make(map[string]string)
+	for _, tfMapRaw := range d.Get("policy_override").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		overrides[tfMap["policy_id"].(string)] = tfMap["content"].(string)
+	}
+
+	// Cache policy content by ID as the same policy is frequently attached at multiple levels.
+	contents := make(map[string]string)
+	matched := make(map[string]bool)
+	var levels []*policySimulationLevel
+	var tfList []interface{}
+
+	for _, id := range path {
+		summaries, err := findPoliciesForTarget(ctx, conn, id, policyType)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing Organizations Policies (%s) for target (%s): %s", policyType, id, err)
+		}
+
+		level := &policySimulationLevel{targetID: id}
+
+		for _, summary := range summaries {
+			policyID := aws.StringValue(summary.Id)
+
+			content, ok := overrides[policyID]
+			if ok {
+				matched[policyID] = true
+			}
+
+			if ok && content == "" {
+				// An override without content simulates detaching the policy.
+				continue
+			}
+
+			if !ok {
+				if content, ok = contents[policyID]; !ok {
+					policy, err := findPolicyByID(ctx, conn, policyID)
+
+					if err != nil {
+						return sdkdiag.AppendErrorf(diags, "reading Organizations Policy (%s): %s", policyID, err)
+					}
+
+					content = aws.StringValue(policy.Content)
+					contents[policyID] = content
+				}
+			}
+
+			doc, err := expandPolicySimulationDocument(content)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "parsing Organizations Policy (%s): %s", policyID, err)
+			}
+
+			level.policies = append(level.policies, &policySimulationPolicy{id: policyID, document: doc})
+			tfList = append(tfList, map[string]interface{}{
+				"name":      aws.StringValue(summary.Name),
+				"policy_id": policyID,
+				"target_id": id,
+			})
+		}
+
+		levels = append(levels, level)
+	}
+
+	var unmatched []string
+	for policyID := range overrides {
+		if !matched[policyID] {
+			unmatched = append(unmatched, policyID)
+		}
+	}
+
+	if len(unmatched) > 0 {
+		sort.Strings(unmatched)
+		return sdkdiag.AppendErrorf(diags, "policy overrides (%s) are not attached in the path (%s) of target (%s)", strings.Join(unmatched, ", "), strings.Join(path, ", "), targetID)
+	}
+
+	for i, tfMapRaw := range d.Get("additional_policy").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		id := tfMap["target_id"].(string)
+		policyID := fmt.Sprintf("additional-%d", i)
+
+		var level *policySimulationLevel
+		for _, v := range levels {
+			if v.targetID == id {
+				level = v
+				break
+			}
+		}
+
+		if level == nil {
+			return sdkdiag.AppendErrorf(diags, "additional policy target (%s) is not in the path (%s) of target (%s)", id, strings.Join(path, ", "), targetID)
+		}
+
+		doc, err := expandPolicySimulationDocument(tfMap["content"].(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "parsing additional policy for target (%s): %s", id, err)
+		}
+
+		level.policies = append(level.policies, &policySimulationPolicy{id: policyID, document: doc})
+		tfList = append(tfList, map[string]interface{}{
+			"policy_id": policyID,
+			"target_id": id,
+		})
+	}
+
+	actionNames := flex.ExpandStringValueSet(d.Get("action_names").(*schema.Set))
+	sort.Strings(actionNames)
+
+	allAllowed := true
+	var tfResults []interface{}
+
+	for _, actionName := range actionNames {
+		result := evaluatePolicySimulation(levels, actionName)
+
+		if result.decision != policySimulationDecisionAllowed {
+			allAllowed = false
+		}
+
+		tfResults = append(tfResults, map[string]interface{}{
+			"action_name":         actionName,
+			"allowed":             result.decision == policySimulationDecisionAllowed,
+			"decision":            result.decision,
+			"denied_at_target_id": result.deniedAtTargetID,
+			"matched_policy_ids":  result.matchedPolicyIDs,
+		})
+	}
+
+	d.SetId(targetID)
+	d.Set("all_allowed", allAllowed)
+	d.Set("path", path)
+	if err := d.Set("policies", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting policies: %s", err)
+	}
+	if err := d.Set("results", tfResults); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results: %s", err)
+	}
+
+	return diags
+}
+
+// findPolicyTargetPath returns the IDs of the organization root, the organizational units and the target itself, from the root down.
+func findPolicyTargetPath(ctx context.Context, conn *organizations.Organizations, targetID string) ([]string, error) {
+	path := []string{targetID}
+
+	for id := targetID; !strings.HasPrefix(id, "r-"); {
+		if len(path) > policySimulationMaxPathLength {
+			return nil, fmt.Errorf("organization path exceeds %d levels", policySimulationMaxPathLength)
+		}
+
+		parentID, err := findParentAccountID(ctx, conn, id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		path = append([]string{parentID}, path...)
+		id = parentID
+	}
+
+	return path, nil
+}
+
+type policySimulationLevel struct {
+	targetID string
+	policies []*policySimulationPolicy
+}
+
+type policySimulationPolicy struct {
+	id       string
+	document *tfiam.IAMPolicyDoc
+}
+
+type policySimulationResult struct {
+	decision         string
+	deniedAtTargetID string
+	matchedPolicyIDs []string
+}
+
+// evaluatePolicySimulation evaluates an action against the policies attached along an organization path.
+// An action is allowed only if every level has at least one policy allowing it and no policy at any level denies it.
+// Condition and Resource elements are not evaluated; matching statements are assumed to apply.
+func evaluatePolicySimulation(levels []*policySimulationLevel, actionName string) *policySimulationResult {
+	var allowedBy []string
+	var implicitDenyAt string
+
+	for _, level := range levels {
+		var levelAllowedBy []string
+
+		for _, policy := range level.policies {
+			for _, statement := range policy.document.Statements {
+				if !policySimulationStatementMatchesAction(statement, actionName) {
+					continue
+				}
+
+				switch statement.Effect {
+				case "Deny":
+					return &policySimulationResult{
+						decision:         policySimulationDecisionExplicitDeny,
+						deniedAtTargetID: level.targetID,
+						matchedPolicyIDs: []string{policy.id},
+					}
+				case "Allow":
+					levelAllowedBy = appendUniqueString(levelAllowedBy, policy.id)
+				}
+			}
+		}
+
+		if len(levelAllowedBy) == 0 && implicitDenyAt == "" {
+			// Keep looking for an explicit deny, which takes precedence.
+			implicitDenyAt = level.targetID
+		}
+
+		for _, v := range levelAllowedBy {
+			allowedBy = appendUniqueString(allowedBy, v)
+		}
+	}
+
+	if implicitDenyAt != "" || len(levels) == 0 {
+		return &policySimulationResult{
+			decision:         policySimulationDecisionImplicitDeny,
+			deniedAtTargetID: implicitDenyAt,
+		}
+	}
+
+	return &policySimulationResult{
+		decision:         policySimulationDecisionAllowed,
+		matchedPolicyIDs: allowedBy,
+	}
+}
+
+func policySimulationStatementMatchesAction(statement *tfiam.IAMPolicyStatement, actionName string) bool {
+	if statement.Actions != nil {
+		for _, pattern := range policySimulationStringList(statement.Actions) {
+			if policySimulationActionMatches(pattern, actionName) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if statement.NotActions != nil {
+		for _, pattern := range policySimulationStringList(statement.NotActions) {
+			if policySimulationActionMatches(pattern, actionName) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// policySimulationActionMatches reports whether an IAM action pattern, which may contain the '*' and '?' wildcards, matches an action name.
+// Matching is case-insensitive.
+func policySimulationActionMatches(pattern, actionName string) bool {
+	pattern, actionName = strings.ToLower(pattern), strings.ToLower(actionName)
+
+	var p, a, starP, starA = 0, 0, -1, 0
+	for a < len(actionName) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == actionName[a]):
+			p++
+			a++
+		case p < len(pattern) && pattern[p] == '*':
+			starP, starA = p, a
+			p++
+		case starP != -1:
+			p = starP + 1
+			starA++
+			a = starA
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
+
+func policySimulationStringList(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var s []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	}
+
+	return nil
+}
+
+func expandPolicySimulationDocument(content string) (*tfiam.IAMPolicyDoc, error) {
+	var raw struct {
+		Version   string
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(content), &raw); err != nil {
+		return nil, err
+	}
+
+	doc := &tfiam.IAMPolicyDoc{Version: raw.Version}
+
+	// The Statement element may be either a single statement or a list of statements.
+	if trimmed := strings.TrimSpace(string(raw.Statement)); strings.HasPrefix(trimmed, "{") {
+		statement := &tfiam.IAMPolicyStatement{}
+
+		if err := json.Unmarshal(raw.Statement, statement); err != nil {
+			return nil, err
+		}
+
+		doc.Statements = append(doc.Statements, statement)
+	} else if len(trimmed) > 0 {
+		if err := json.Unmarshal(raw.Statement, &doc.Statements); err != nil {
+			return nil, err
+		}
+	}
+
+	return doc, nil
+}
+
+func appendUniqueString(s []string, v string) []string {
+	for _, e := range s {
+		if e == v {
+			return s
+		}
+	}
+
+	return append(s, v)
+}
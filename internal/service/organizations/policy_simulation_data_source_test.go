@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccPolicySimulationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_organizations_policy_simulation.test"
+	ouResourceName := "aws_organizations_organizational_unit.test"
+	policyResourceName := "aws_organizations_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicySimulationDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "path.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "path.1", ouResourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action_name", "ec2:DescribeInstances"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.action_name", "s3:DeleteBucket"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.decision", "explicitDeny"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "results.1.denied_at_target_id", ouResourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "results.1.matched_policy_ids.0", policyResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicySimulationDataSource_policyOverride(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_organizations_policy_simulation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicySimulationDataSourceConfig_policyOverride(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.action_name", "s3:DeleteBucket"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.decision", "allowed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicySimulationDataSource_policyOverrideNotAttached(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicySimulationDataSourceConfig_policyOverrideNotAttached(rName),
+				ExpectError: regexp.MustCompile(`policy overrides \(p-00000000\) are not attached in the path`),
+			},
+		},
+	})
+}
+
+func testAccPolicySimulationDataSource_additionalPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_organizations_policy_simulation.test"
+	ouResourceName := "aws_organizations_organizational_unit.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicySimulationDataSourceConfig_additionalPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action_name", "ec2:DescribeInstances"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "explicitDeny"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "results.0.denied_at_target_id", ouResourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_policy_ids.0", "additional-0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicySimulationDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  feature_set          = "ALL"
+  enabled_policy_types = ["SERVICE_CONTROL_POLICY"]
+}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %[1]q
+  parent_id = aws_organizations_organization.test.roots[0].id
+}
+
+resource "aws_organizations_policy" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  content = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Deny",
+    "Action": "s3:Delete*",
+    "Resource": "*"
+  }
+}
+EOF
+
+  name = %[1]q
+}
+
+resource "aws_organizations_policy_attachment" "test" {
+  policy_id = aws_organizations_policy.test.id
+  target_id = aws_organizations_organizational_unit.test.id
+}
+`, rName)
+}
+
+func testAccPolicySimulationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPolicySimulationDataSourceConfig_base(rName), `
+data "aws_organizations_policy_simulation" "test" {
+  depends_on = [aws_organizations_policy_attachment.test]
+
+  target_id    = aws_organizations_organizational_unit.test.id
+  action_names = ["ec2:DescribeInstances", "s3:DeleteBucket"]
+}
+`)
+}
+
+func testAccPolicySimulationDataSourceConfig_policyOverride(rName string) string {
+	return acctest.ConfigCompose(testAccPolicySimulationDataSourceConfig_base(rName), `
+data "aws_organizations_policy_simulation" "test" {
+  depends_on = [aws_organizations_policy_attachment.test]
+
+  target_id    = aws_organizations_organizational_unit.test.id
+  action_names = ["ec2:DescribeInstances", "s3:DeleteBucket"]
+
+  policy_override {
+    policy_id = aws_organizations_policy.test.id
+  }
+}
+`)
+}
+
+func testAccPolicySimulationDataSourceConfig_policyOverrideNotAttached(rName string) string {
+	return acctest.ConfigCompose(testAccPolicySimulationDataSourceConfig_base(rName), `
+data "aws_organizations_policy_simulation" "test" {
+  depends_on = [aws_organizations_policy_attachment.test]
+
+  target_id    = aws_organizations_organizational_unit.test.id
+  action_names = ["ec2:DescribeInstances"]
+
+  policy_override {
+    policy_id = "p-00000000"
+  }
+}
+`)
+}
+
+func testAccPolicySimulationDataSourceConfig_additionalPolicy(rName string) string {
+	return acctest.ConfigCompose(testAccPolicySimulationDataSourceConfig_base(rName), `
+data "aws_organizations_policy_simulation" "test" {
+  depends_on = [aws_organizations_policy_attachment.test]
+
+  target_id    = aws_organizations_organizational_unit.test.id
+  action_names = ["ec2:DescribeInstances", "s3:DeleteBucket"]
+
+  additional_policy {
+    target_id = aws_organizations_organizational_unit.test.id
+    content = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Effect   = "Deny"
+        Action   = "ec2:*"
+        Resource = "*"
+      }]
+    })
+  }
+}
+`)
+}
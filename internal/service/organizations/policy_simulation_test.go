@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPolicySimulationActionMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern    string
+		actionName string
+		expected   bool
+	}{
+		{"*", "s3:GetObject", true},
+		{"s3:*", "s3:GetObject", true},
+		{"S3:get*", "s3:GetObject", true},
+		{"s3:Get*", "s3:PutObject", false},
+		{"s3:Get?bject", "s3:GetObject", true},
+		{"s3:*Object", "s3:GetObjectAcl", false},
+		{"s3:*Object*", "s3:GetObjectAcl", true},
+		{"ec2:*", "s3:GetObject", false},
+		{"s3:GetObject", "s3:GetObject", true},
+	}
+
+	for _, testCase := range testCases {
+		if got := policySimulationActionMatches(testCase.pattern, testCase.actionName); got != testCase.expected {
+			t.Errorf("policySimulationActionMatches(%q, %q) = %t, expected %t", testCase.pattern, testCase.actionName, got, testCase.expected)
+		}
+	}
+}
+
+func TestEvaluatePolicySimulation(t *testing.T) {
+	t.Parallel()
+
+	mustExpand := func(content string) *policySimulationPolicy {
+		doc, err := expandPolicySimulationDocument(content)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		return &policySimulationPolicy{id: content, document: doc}
+	}
+
+	fullAccess := mustExpand(`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"*","Resource":"*"}}`)
+	fullAccess.id = "p-FullAWSAccess"
+	denyS3 := mustExpand(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":["s3:Delete*"],"Resource":"*"}]}`)
+	denyS3.id = "p-denys3"
+	allowEC2 := mustExpand(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"*"}]}`)
+	allowEC2.id = "p-allowec2"
+	denyAllButIAM := mustExpand(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":"iam:*","Resource":"*"}]}`)
+	denyAllButIAM.id = "p-denyallbutiam"
+
+	testCases := map[string]struct {
+		levels     []*policySimulationLevel
+		actionName string
+		expected   *policySimulationResult
+	}{
+		"allowed": {
+			levels: []*policySimulationLevel{
+				{targetID: "r-1234", policies: []*policySimulationPolicy{fullAccess}},
+				{targetID: "ou-1234-abcdefgh", policies: []*policySimulationPolicy{fullAccess, allowEC2}},
+			},
+			actionName: "ec2:RunInstances",
+			expected: &policySimulationResult{
+				decision:         policySimulationDecisionAllowed,
+				matchedPolicyIDs: []string{"p-FullAWSAccess", "p-allowec2"},
+			},
+		},
+		"explicit deny": {
+			levels: []*policySimulationLevel{
+				{targetID: "r-1234", policies: []*policySimulationPolicy{fullAccess}},
+				{targetID: "ou-1234-abcdefgh", policies: []*policySimulationPolicy{fullAccess, denyS3}},
+			},
+			actionName: "s3:DeleteBucket",
+			expected: &policySimulationResult{
+				decision:         policySimulationDecisionExplicitDeny,
+				deniedAtTargetID: "ou-1234-abcdefgh",
+				matchedPolicyIDs: []string{"p-denys3"},
+			},
+		},
+		"implicit deny": {
+			levels: []*policySimulationLevel{
+				{targetID: "r-1234", policies: []*policySimulationPolicy{fullAccess}},
+				{targetID: "ou-1234-abcdefgh", policies: []*policySimulationPolicy{allowEC2}},
+				{targetID: "123456789012", policies: []*policySimulationPolicy{fullAccess}},
+			},
+			actionName: "s3:GetObject",
+			expected: &policySimulationResult{
+				decision:         policySimulationDecisionImplicitDeny,
+				deniedAtTargetID: "ou-1234-abcdefgh",
+			},
+		},
+		"explicit deny below implicit deny": {
+			levels: []*policySimulationLevel{
+				{targetID: "r-1234", policies: []*policySimulationPolicy{allowEC2}},
+				{targetID: "123456789012", policies: []*policySimulationPolicy{fullAccess, denyS3}},
+			},
+			actionName: "s3:DeleteObject",
+			expected: &policySimulationResult{
+				decision:         policySimulationDecisionExplicitDeny,
+				deniedAtTargetID: "123456789012",
+				matchedPolicyIDs: []string{"p-denys3"},
+			},
+		},
+		"not action": {
+			levels: []*policySimulationLevel{
+				{targetID: "r-1234", policies: []*policySimulationPolicy{fullAccess, denyAllButIAM}},
+			},
+			actionName: "iam:CreateRole",
+			expected: &policySimulationResult{
+				decision:         policySimulationDecisionAllowed,
+				matchedPolicyIDs: []string{"p-FullAWSAccess"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := evaluatePolicySimulation(testCase.levels, testCase.actionName)
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.expected)
+			}
+		})
+	}
+}
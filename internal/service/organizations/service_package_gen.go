@@ -61,6 +61,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourcePolicy,
 			TypeName: "aws_organizations_policy",
 		},
+		{
+			Factory:  DataSourcePolicySimulation,
+			TypeName: "aws_organizations_policy_simulation",
+		},
 		{
 			Factory:  DataSourceResourceTags,
 			TypeName: "aws_organizations_resource_tags",
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -90,17 +91,9 @@ func resourceBucketAccelerateConfigurationRead(ctx context.Context, d *schema.Re
 		return diag.FromErr(err)
 	}
 
-	input := &s3.GetBucketAccelerateConfigurationInput{
-		Bucket: aws.String(bucket),
-	}
-
-	if expectedBucketOwner != "" {
-		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
-	}
-
-	output, err := conn.GetBucketAccelerateConfigurationWithContext(ctx, input)
+	output, err := findBucketAccelerateConfiguration(ctx, conn, bucket, expectedBucketOwner)
 
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] S3 Bucket Accelerate Configuration (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -110,15 +103,6 @@ func resourceBucketAccelerateConfigurationRead(ctx context.Context, d *schema.Re
 		return diag.Errorf("reading S3 bucket accelerate configuration (%s): %s", d.Id(), err)
 	}
 
-	if output == nil {
-		if d.IsNewResource() {
-			return diag.Errorf("reading S3 bucket accelerate configuration (%s): empty output", d.Id())
-		}
-		log.Printf("[WARN] S3 Bucket Accelerate Configuration (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
-	}
-
 	d.Set("bucket", bucket)
 	d.Set("expected_bucket_owner", expectedBucketOwner)
 	d.Set("status", output.Status)
@@ -185,3 +169,31 @@ func resourceBucketAccelerateConfigurationDelete(ctx context.Context, d *schema.
 
 	return nil
 }
+
+func findBucketAccelerateConfiguration(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (*s3.GetBucketAccelerateConfigurationOutput, error) {
+	input := &s3.GetBucketAccelerateConfigurationInput{
+		Bucket: aws.String(bucket),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketAccelerateConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
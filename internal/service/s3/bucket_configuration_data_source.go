@@ -0,0 +1,474 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_s3_bucket_configuration", name="Bucket Configuration")
+func dataSourceBucketConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceBucketConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"acceleration_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_control_policy": computedSchemaFromResourceSchema(ResourceBucketACL().Schema["access_control_policy"]),
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"configuration_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cors_rule": computedSchemaFromResourceSchema(ResourceBucketCorsConfiguration().Schema["cors_rule"]),
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"lifecycle_rule": computedSchemaFromResourceSchema(ResourceBucketLifecycleConfiguration().Schema["rule"]),
+			"logging": computedSchemaFromResourceSchema(&schema.Schema{
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: resourceSchemaSubset(ResourceBucketLogging().Schema, "target_bucket", "target_grant", "target_prefix"),
+				},
+			}),
+			"notification": computedSchemaFromResourceSchema(&schema.Schema{
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: resourceSchemaSubset(ResourceBucketNotification().Schema, "eventbridge", "lambda_function", "queue", "topic"),
+				},
+			}),
+			"object_lock_configuration": computedSchemaFromResourceSchema(&schema.Schema{
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: resourceSchemaSubset(ResourceBucketObjectLockConfiguration().Schema, "object_lock_enabled", "rule"),
+				},
+			}),
+			"object_ownership": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_access_block": computedSchemaFromResourceSchema(&schema.Schema{
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: resourceSchemaSubset(ResourceBucketPublicAccessBlock().Schema, "block_public_acls", "block_public_policy", "ignore_public_acls", "restrict_public_buckets"),
+				},
+			}),
+			"replication_configuration": computedSchemaFromResourceSchema(&schema.Schema{
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: resourceSchemaSubset(ResourceBucketReplicationConfiguration().Schema, "role", "rule"),
+				},
+			}),
+			"request_payer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server_side_encryption_rule": computedSchemaFromResourceSchema(ResourceBucketServerSideEncryptionConfiguration().Schema["rule"]),
+			"tags":                        tftags.TagsSchemaComputed(),
+			"versioning_configuration":    computedSchemaFromResourceSchema(ResourceBucketVersioning().Schema["versioning_configuration"]),
+			"website": computedSchemaFromResourceSchema(&schema.Schema{
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: resourceSchemaSubset(ResourceBucketWebsiteConfiguration().Schema, "error_document", "index_document", "redirect_all_requests_to", "routing_rule"),
+				},
+			}),
+		},
+	}
+}
+
+func dataSourceBucketConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+
+	if err := FindBucket(ctx, conn, bucket); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s): %s", bucket, err)
+	}
+
+	config, err := findBucketConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) configuration: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	if v := config.accelerate; v != nil {
+		d.Set("acceleration_status", v.Status)
+	} else {
+		d.Set("acceleration_status", nil)
+	}
+	if err := d.Set("access_control_policy", flattenBucketACLAccessControlPolicy(config.acl)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting access_control_policy: %s", err)
+	}
+	if v := config.cors; v != nil {
+		if err := d.Set("cors_rule", flattenBucketCorsConfigurationCorsRules(v.CORSRules)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting cors_rule: %s", err)
+		}
+	} else {
+		d.Set("cors_rule", nil)
+	}
+	if v := config.lifecycle; v != nil {
+		if err := d.Set("lifecycle_rule", FlattenLifecycleRules(ctx, v.Rules)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting lifecycle_rule: %s", err)
+		}
+	} else {
+		d.Set("lifecycle_rule", nil)
+	}
+	if v := config.logging; v != nil && v.LoggingEnabled != nil {
+		if err := d.Set("logging", []interface{}{map[string]interface{}{
+			"target_bucket": aws.StringValue(v.LoggingEnabled.TargetBucket),
+			"target_grant":  flattenBucketLoggingTargetGrants(v.LoggingEnabled.TargetGrants),
+			"target_prefix": aws.StringValue(v.LoggingEnabled.TargetPrefix),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting logging: %s", err)
+		}
+	} else {
+		d.Set("logging", nil)
+	}
+	if v := config.notification; v != nil && (v.EventBridgeConfiguration != nil || len(v.LambdaFunctionConfigurations) > 0 || len(v.QueueConfigurations) > 0 || len(v.TopicConfigurations) > 0) {
+		if err := d.Set("notification", []interface{}{map[string]interface{}{
+			"eventbridge":     v.EventBridgeConfiguration != nil,
+			"lambda_function": flattenLambdaFunctionConfigurations(v.LambdaFunctionConfigurations),
+			"queue":           flattenQueueConfigurations(v.QueueConfigurations),
+			"topic":           flattenTopicConfigurations(v.TopicConfigurations),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting notification: %s", err)
+		}
+	} else {
+		d.Set("notification", nil)
+	}
+	if v := config.objectLock; v != nil {
+		if err := d.Set("object_lock_configuration", []interface{}{map[string]interface{}{
+			"object_lock_enabled": aws.StringValue(v.ObjectLockEnabled),
+			"rule":                flattenBucketObjectLockConfigurationRule(v.Rule),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting object_lock_configuration: %s", err)
+		}
+	} else {
+		d.Set("object_lock_configuration", nil)
+	}
+	if v := config.ownershipControls; v != nil && len(v.Rules) > 0 && v.Rules[0] != nil {
+		d.Set("object_ownership", v.Rules[0].ObjectOwnership)
+	} else {
+		d.Set("object_ownership", nil)
+	}
+	if v := config.policy; v != nil {
+		policy, err := structure.NormalizeJsonString(aws.StringValue(v.Policy))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", aws.StringValue(v.Policy), err)
+		}
+
+		d.Set("policy", policy)
+	} else {
+		d.Set("policy", nil)
+	}
+	if v := config.publicAccessBlock; v != nil {
+		if err := d.Set("public_access_block", []interface{}{map[string]interface{}{
+			"block_public_acls":       aws.BoolValue(v.BlockPublicAcls),
+			"block_public_policy":     aws.BoolValue(v.BlockPublicPolicy),
+			"ignore_public_acls":      aws.BoolValue(v.IgnorePublicAcls),
+			"restrict_public_buckets": aws.BoolValue(v.RestrictPublicBuckets),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting public_access_block: %s", err)
+		}
+	} else {
+		d.Set("public_access_block", nil)
+	}
+	if v := config.replication; v != nil && v.ReplicationConfiguration != nil {
+		if err := d.Set("replication_configuration", []interface{}{map[string]interface{}{
+			"role": aws.StringValue(v.ReplicationConfiguration.Role),
+			"rule": FlattenReplicationRules(ctx, v.ReplicationConfiguration.Rules),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting replication_configuration: %s", err)
+		}
+	} else {
+		d.Set("replication_configuration", nil)
+	}
+	if v := config.requestPayment; v != nil {
+		d.Set("request_payer", v.Payer)
+	} else {
+		d.Set("request_payer", nil)
+	}
+	if v := config.encryption; v != nil {
+		if err := d.Set("server_side_encryption_rule", flattenBucketServerSideEncryptionConfigurationRules(v.Rules)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting server_side_encryption_rule: %s", err)
+		}
+	} else {
+		d.Set("server_side_encryption_rule", nil)
+	}
+	if err := d.Set("tags", config.tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+	if err := d.Set("versioning_configuration", flattenBucketVersioningConfiguration(config.versioning)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting versioning_configuration: %s", err)
+	}
+	if v := config.website; v != nil {
+		if err := d.Set("website", []interface{}{map[string]interface{}{
+			"error_document":           flattenBucketWebsiteConfigurationErrorDocument(v.ErrorDocument),
+			"index_document":           flattenBucketWebsiteConfigurationIndexDocument(v.IndexDocument),
+			"redirect_all_requests_to": flattenBucketWebsiteConfigurationRedirectAllRequestsTo(v.RedirectAllRequestsTo),
+			"routing_rule":             flattenBucketWebsiteConfigurationRoutingRules(v.RoutingRules),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting website: %s", err)
+		}
+	} else {
+		d.Set("website", nil)
+	}
+
+	configurationJSON, err := config.snapshot()
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "serializing S3 Bucket (%s) configuration: %s", bucket, err)
+	}
+
+	d.Set("configuration_json", configurationJSON)
+
+	return diags
+}
+
+// bucketConfiguration holds every sub-configuration of an S3 bucket.
+// A nil field means that the sub-configuration is not set on the bucket.
+type bucketConfiguration struct {
+	accelerate        *s3.GetBucketAccelerateConfigurationOutput
+	acl               *s3.GetBucketAclOutput
+	cors              *s3.GetBucketCorsOutput
+	encryption        *s3.ServerSideEncryptionConfiguration
+	lifecycle         *s3.GetBucketLifecycleConfigurationOutput
+	logging           *s3.GetBucketLoggingOutput
+	notification      *s3.NotificationConfiguration
+	objectLock        *s3.ObjectLockConfiguration
+	ownershipControls *s3.OwnershipControls
+	policy            *s3.GetBucketPolicyOutput
+	publicAccessBlock *s3.PublicAccessBlockConfiguration
+	replication       *s3.GetBucketReplicationOutput
+	requestPayment    *s3.GetBucketRequestPaymentOutput
+	tags              tftags.KeyValueTags
+	versioning        *s3.GetBucketVersioningOutput
+	website           *s3.GetBucketWebsiteOutput
+}
+
+// snapshot returns the configuration as a normalized JSON document.
+// Keys are sorted and every sub-configuration is present, with null marking those that aren't set.
+func (c *bucketConfiguration) snapshot() (string, error) {
+	m := map[string]interface{}{
+		"AccelerateConfiguration":           c.accelerate,
+		"Acl":                               c.acl,
+		"Cors":                              nil,
+		"Lifecycle":                         nil,
+		"Logging":                           nil,
+		"Notification":                      c.notification,
+		"ObjectLockConfiguration":           c.objectLock,
+		"OwnershipControls":                 c.ownershipControls,
+		"Policy":                            nil,
+		"PublicAccessBlock":                 c.publicAccessBlock,
+		"Replication":                       nil,
+		"RequestPayment":                    c.requestPayment,
+		"ServerSideEncryptionConfiguration": c.encryption,
+		"Tagging":                           c.tags.Map(),
+		"Versioning":                        c.versioning,
+		"Website":                           c.website,
+	}
+
+	if v := c.cors; v != nil {
+		m["Cors"] = v.CORSRules
+	}
+	if v := c.lifecycle; v != nil {
+		m["Lifecycle"] = v.Rules
+	}
+	if v := c.logging; v != nil {
+		m["Logging"] = v.LoggingEnabled
+	}
+	if v := c.policy; v != nil {
+		policy, err := structure.NormalizeJsonString(aws.StringValue(v.Policy))
+
+		if err != nil {
+			return "", err
+		}
+
+		m["Policy"] = json.RawMessage(policy)
+	}
+	if v := c.replication; v != nil {
+		m["Replication"] = v.ReplicationConfiguration
+	}
+
+	b, err := json.Marshal(m)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// findBucketConfiguration reads all of a bucket's sub-configurations concurrently.
+func findBucketConfiguration(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (*bucketConfiguration, error) {
+	var (
+		config bucketConfiguration
+		errs   *multierror.Error
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+	)
+
+	read := func(name string, f func() error) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := f(); err != nil && !tfresource.NotFound(err) && !bucketConfigurationNotSupported(err) {
+				mu.Lock()
+				errs = multierror.Append(errs, fmt.Errorf("%s: %w", name, err))
+				mu.Unlock()
+			}
+		}()
+	}
+
+	read("accelerate configuration", func() (err error) {
+		config.accelerate, err = findBucketAccelerateConfiguration(ctx, conn, bucket, expectedBucketOwner)
+		return
+	})
+	read("ACL", func() (err error) {
+		config.acl, err = findBucketACL(ctx, conn, bucket, expectedBucketOwner)
+		return
+	})
+	read("CORS configuration", func() (err error) {
+		config.cors, err = findBucketCORSConfiguration(ctx, conn, bucket, expectedBucketOwner)
+		return
+	})
+	read("lifecycle configuration", func() (err error) {
+		config.lifecycle, err = findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
+		return
+	})
+	read("logging", func() (err error) {
+		config.logging, err = FindBucketLoggingByID(ctx, conn, bucket, expectedBucketOwner)
+		return
+	})
+	read("notification configuration", func() (err error) {
+		config.notification, err = findBucketNotificationConfiguration(ctx, conn, bucket, expectedBucketOwner)
+		return
+	})
+	read("Object Lock configuration", func() (err error) {
+		config.objectLock, err = FindObjectLockConfiguration(ctx, conn, bucket, expectedBucketOwner)
+		return
+	})
+	read("ownership controls", func() (err error) {
+		config.ownershipControls, err = findBucketOwnershipControls(ctx, conn, bucket, expectedBucketOwner)
+		return
+	})
+	read("policy", func() (err error) {
+		config.policy, err = FindBucketPolicy(ctx, conn, bucket)
+		return
+	})
+	read("public access block", func() (err error) {
+		config.publicAccessBlock, err = findBucketPublicAccessBlockConfiguration(ctx, conn, bucket, expectedBucketOwner)
+		return
+	})
+	read("replication configuration", func() (err error) {
+		config.replication, err = FindBucketReplicationConfigurationByID(ctx, conn, bucket)
+		return
+	})
+	read("request payment configuration", func() (err error) {
+		config.requestPayment, err = findBucketRequestPaymentConfiguration(ctx, conn, bucket, expectedBucketOwner)
+		return
+	})
+	read("server-side encryption configuration", func() (err error) {
+		config.encryption, err = findBucketServerSideEncryptionConfiguration(ctx, conn, bucket, expectedBucketOwner)
+		return
+	})
+	read("tags", func() (err error) {
+		config.tags, err = BucketListTags(ctx, conn, bucket)
+		return
+	})
+	read("versioning", func() (err error) {
+		config.versioning, err = findBucketVersioning(ctx, conn, bucket, expectedBucketOwner)
+		return
+	})
+	read("website configuration", func() (err error) {
+		config.website, err = findBucketWebsiteConfiguration(ctx, conn, bucket, expectedBucketOwner)
+		return
+	})
+
+	wg.Wait()
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// bucketConfigurationNotSupported returns whether the error indicates that a sub-configuration
+// isn't supported, e.g. in some partitions or by third-party S3 implementations.
+func bucketConfigurationNotSupported(err error) bool {
+	return tfawserr.ErrCodeEquals(err, errCodeMethodNotAllowed, errCodeNotImplemented, errCodeUnsupportedArgument, errCodeXNotImplemented)
+}
+
+// resourceSchemaSubset returns the named attributes of a resource schema.
+func resourceSchemaSubset(s map[string]*schema.Schema, keys ...string) map[string]*schema.Schema {
+	m := make(map[string]*schema.Schema, len(keys))
+
+	for _, k := range keys {
+		m[k] = s[k]
+	}
+
+	return m
+}
+
+// computedSchemaFromResourceSchema returns a computed-only copy of a resource attribute's schema
+// so that a data source exposes exactly the same structure as the resource that manages the attribute.
+func computedSchemaFromResourceSchema(s *schema.Schema) *schema.Schema {
+	v := &schema.Schema{
+		Type:      s.Type,
+		Computed:  true,
+		Sensitive: s.Sensitive,
+		Set:       s.Set,
+	}
+
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		m := make(map[string]*schema.Schema, len(elem.Schema))
+
+		for k, s := range elem.Schema {
+			m[k] = computedSchemaFromResourceSchema(s)
+		}
+
+		v.Elem = &schema.Resource{Schema: m}
+	case *schema.Schema:
+		v.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	return v
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3BucketConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfigurationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "acceleration_status", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "access_control_policy.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "cors_rule.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "logging.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "notification.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "object_lock_configuration.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "object_ownership", "BucketOwnerEnforced"),
+					resource.TestCheckResourceAttr(dataSourceName, "policy", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.block_public_acls", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "replication_configuration.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "request_payer", "BucketOwner"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", "AES256"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning_configuration.0.status", "Disabled"),
+					resource.TestCheckResourceAttr(dataSourceName, "website.#", "0"),
+					resource.TestMatchResourceAttr(dataSourceName, "configuration_json", regexp.MustCompile(`"Policy":null`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketConfigurationDataSource_subConfigurations(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfigurationDataSourceConfig_subConfigurations(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "cors_rule.0.allowed_methods.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.id", "expire"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.expiration.0.days", "90"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policy", "aws_s3_bucket_policy.test", "policy"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning_configuration.0.status", "Enabled"),
+					resource.TestCheckResourceAttr(dataSourceName, "website.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "website.0.index_document.0.suffix", "index.html"),
+					resource.TestMatchResourceAttr(dataSourceName, "configuration_json", regexp.MustCompile(`"Versioning":\{[^}]*"Status":"Enabled"`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketConfigurationDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+data "aws_s3_bucket_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+}
+`, rName)
+}
+
+func testAccBucketConfigurationDataSourceConfig_subConfigurations(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_s3_bucket_cors_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  cors_rule {
+    allowed_methods = ["GET"]
+    allowed_origins = ["https://www.example.com"]
+  }
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    id     = "expire"
+    status = "Enabled"
+
+    filter {
+      prefix = "logs/"
+    }
+
+    expiration {
+      days = 90
+    }
+  }
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Deny"
+      Principal = "*"
+      Action    = "s3:*"
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+      Condition = {
+        Bool = {
+          "aws:SecureTransport" = "false"
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_website_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  index_document {
+    suffix = "index.html"
+  }
+}
+
+data "aws_s3_bucket_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  depends_on = [
+    aws_s3_bucket_cors_configuration.test,
+    aws_s3_bucket_lifecycle_configuration.test,
+    aws_s3_bucket_policy.test,
+    aws_s3_bucket_versioning.test,
+    aws_s3_bucket_website_configuration.test,
+  ]
+}
+`, rName)
+}
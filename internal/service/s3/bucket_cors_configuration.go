@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -124,19 +125,11 @@ func resourceBucketCorsConfigurationRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	input := &s3.GetBucketCorsInput{
-		Bucket: aws.String(bucket),
-	}
-
-	if expectedBucketOwner != "" {
-		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
-	}
-
-	corsResponse, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
-		return conn.GetBucketCorsWithContext(ctx, input)
-	}, ErrCodeNoSuchCORSConfiguration)
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, 2*time.Minute, func() (interface{}, error) {
+		return findBucketCORSConfiguration(ctx, conn, bucket, expectedBucketOwner)
+	}, d.IsNewResource())
 
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchCORSConfiguration) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] S3 Bucket CORS Configuration (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -146,15 +139,7 @@ func resourceBucketCorsConfigurationRead(ctx context.Context, d *schema.Resource
 		return diag.Errorf("reading S3 bucket CORS configuration (%s): %s", d.Id(), err)
 	}
 
-	output, ok := corsResponse.(*s3.GetBucketCorsOutput)
-	if !ok || output == nil {
-		if d.IsNewResource() {
-			return diag.Errorf("reading S3 bucket CORS configuration (%s): empty output", d.Id())
-		}
-		log.Printf("[WARN] S3 Bucket CORS Configuration (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
-	}
+	output := outputRaw.(*s3.GetBucketCorsOutput)
 
 	d.Set("bucket", bucket)
 	d.Set("expected_bucket_owner", expectedBucketOwner)
@@ -307,3 +292,31 @@ func flattenBucketCorsConfigurationCorsRules(rules []*s3.CORSRule) []interface{}
 
 	return results
 }
+
+func findBucketCORSConfiguration(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (*s3.GetBucketCorsOutput, error) {
+	input := &s3.GetBucketCorsInput{
+		Bucket: aws.String(bucket),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketCorsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchCORSConfiguration) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.CORSRules) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -385,11 +385,9 @@ func resourceBucketNotificationRead(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Conn(ctx)
 
-	notificationConfigs, err := conn.GetBucketNotificationConfigurationWithContext(ctx, &s3.GetBucketNotificationConfigurationRequest{
-		Bucket: aws.String(d.Id()),
-	})
+	notificationConfigs, err := findBucketNotificationConfiguration(ctx, conn, d.Id(), "")
 
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] S3 Bucket Notification Configuration (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return diags
@@ -399,10 +397,6 @@ func resourceBucketNotificationRead(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Notification Configuration (%s): %s", d.Id(), err)
 	}
 
-	if notificationConfigs == nil {
-		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Notification Configuration (%s): empty response", d.Id())
-	}
-
 	log.Printf("[DEBUG] S3 Bucket: %s, get notification: %v", d.Id(), notificationConfigs)
 
 	d.Set("bucket", d.Id())
@@ -501,3 +495,31 @@ func flattenLambdaFunctionConfigurations(configs []*s3.LambdaFunctionConfigurati
 
 	return lambdaFunctionNotifications
 }
+
+func findBucketNotificationConfiguration(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (*s3.NotificationConfiguration, error) {
+	input := &s3.GetBucketNotificationConfigurationRequest{
+		Bucket: aws.String(bucket),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketNotificationConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -108,60 +108,28 @@ func resourceBucketPublicAccessBlockRead(ctx context.Context, d *schema.Resource
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Conn(ctx)
 
-	input := &s3.GetPublicAccessBlockInput{
-		Bucket: aws.String(d.Id()),
-	}
-
 	// Retry for eventual consistency on creation
-	var output *s3.GetPublicAccessBlockOutput
-	err := retry.RetryContext(ctx, propagationTimeout, func() *retry.RetryError {
-		var err error
-		output, err = conn.GetPublicAccessBlockWithContext(ctx, input)
-
-		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
-			return retry.RetryableError(err)
-		}
-
-		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchPublicAccessBlockConfiguration) {
-			return retry.RetryableError(err)
-		}
-
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
-
-		return nil
-	})
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return findBucketPublicAccessBlockConfiguration(ctx, conn, d.Id(), "")
+	}, d.IsNewResource())
 
-	if tfresource.TimedOut(err) {
-		output, err = conn.GetPublicAccessBlockWithContext(ctx, input)
-	}
-
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchPublicAccessBlockConfiguration) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] S3 Bucket Public Access Block (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return diags
 	}
 
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
-		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return diags
-	}
-
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading S3 bucket Public Access Block (%s): %s", d.Id(), err)
 	}
 
-	if output == nil || output.PublicAccessBlockConfiguration == nil {
-		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Public Access Block (%s): empty response", d.Id())
-	}
+	output := outputRaw.(*s3.PublicAccessBlockConfiguration)
 
 	d.Set("bucket", d.Id())
-	d.Set("block_public_acls", output.PublicAccessBlockConfiguration.BlockPublicAcls)
-	d.Set("block_public_policy", output.PublicAccessBlockConfiguration.BlockPublicPolicy)
-	d.Set("ignore_public_acls", output.PublicAccessBlockConfiguration.IgnorePublicAcls)
-	d.Set("restrict_public_buckets", output.PublicAccessBlockConfiguration.RestrictPublicBuckets)
+	d.Set("block_public_acls", output.BlockPublicAcls)
+	d.Set("block_public_policy", output.BlockPublicPolicy)
+	d.Set("ignore_public_acls", output.IgnorePublicAcls)
+	d.Set("restrict_public_buckets", output.RestrictPublicBuckets)
 
 	return diags
 }
@@ -224,3 +192,31 @@ func resourceBucketPublicAccessBlockDelete(ctx context.Context, d *schema.Resour
 
 	return diags
 }
+
+func findBucketPublicAccessBlockConfiguration(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (*s3.PublicAccessBlockConfiguration, error) {
+	input := &s3.GetPublicAccessBlockInput{
+		Bucket: aws.String(bucket),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetPublicAccessBlockWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchPublicAccessBlockConfiguration) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PublicAccessBlockConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PublicAccessBlockConfiguration, nil
+}
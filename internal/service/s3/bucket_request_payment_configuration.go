@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -90,24 +91,16 @@ func resourceBucketRequestPaymentConfigurationRead(ctx context.Context, d *schem
 		return diag.FromErr(err)
 	}
 
-	input := &s3.GetBucketRequestPaymentInput{
-		Bucket: aws.String(bucket),
-	}
-
-	if expectedBucketOwner != "" {
-		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
-	}
-
-	output, err := conn.GetBucketRequestPaymentWithContext(ctx, input)
+	output, err := findBucketRequestPaymentConfiguration(ctx, conn, bucket, expectedBucketOwner)
 
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] S3 Bucket Request Payment Configuration (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	if output == nil {
-		return diag.Errorf("reading S3 bucket request payment configuration (%s): empty output", d.Id())
+	if err != nil {
+		return diag.Errorf("reading S3 bucket request payment configuration (%s): %s", d.Id(), err)
 	}
 
 	d.Set("bucket", bucket)
@@ -178,3 +171,31 @@ func resourceBucketRequestPaymentConfigurationDelete(ctx context.Context, d *sch
 
 	return nil
 }
+
+func findBucketRequestPaymentConfiguration(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (*s3.GetBucketRequestPaymentOutput, error) {
+	input := &s3.GetBucketRequestPaymentInput{
+		Bucket: aws.String(bucket),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketRequestPaymentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -118,23 +119,11 @@ func resourceBucketServerSideEncryptionConfigurationRead(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	input := &s3.GetBucketEncryptionInput{
-		Bucket: aws.String(bucket),
-	}
-
-	if expectedBucketOwner != "" {
-		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
-	}
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return findBucketServerSideEncryptionConfiguration(ctx, conn, bucket, expectedBucketOwner)
+	}, d.IsNewResource())
 
-	resp, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout,
-		func() (interface{}, error) {
-			return conn.GetBucketEncryptionWithContext(ctx, input)
-		},
-		s3.ErrCodeNoSuchBucket,
-		ErrCodeServerSideEncryptionConfigurationNotFound,
-	)
-
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeServerSideEncryptionConfigurationNotFound) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] S3 Bucket Server-Side Encryption Configuration (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -144,17 +133,7 @@ func resourceBucketServerSideEncryptionConfigurationRead(ctx context.Context, d
 		return diag.Errorf("reading S3 bucket server-side encryption configuration (%s): %s", d.Id(), err)
 	}
 
-	output, ok := resp.(*s3.GetBucketEncryptionOutput)
-	if !ok || output.ServerSideEncryptionConfiguration == nil {
-		if d.IsNewResource() {
-			return diag.Errorf("reading S3 bucket server-side encryption configuration (%s): empty output", d.Id())
-		}
-		log.Printf("[WARN] S3 Bucket Server-Side Encryption Configuration (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
-	}
-
-	sse := output.ServerSideEncryptionConfiguration
+	sse := outputRaw.(*s3.ServerSideEncryptionConfiguration)
 
 	d.Set("bucket", bucket)
 	d.Set("expected_bucket_owner", expectedBucketOwner)
@@ -315,3 +294,31 @@ func flattenBucketServerSideEncryptionConfigurationRuleApplyServerSideEncryption
 
 	return []interface{}{m}
 }
+
+func findBucketServerSideEncryptionConfiguration(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (*s3.ServerSideEncryptionConfiguration, error) {
+	input := &s3.GetBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketEncryptionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeServerSideEncryptionConfigurationNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ServerSideEncryptionConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ServerSideEncryptionConfiguration, nil
+}
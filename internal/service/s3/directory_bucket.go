@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_s3_directory_bucket", name="Directory Bucket")
+func ResourceDirectoryBucket() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectoryBucketCreate,
+		ReadWithoutTimeout:   resourceDirectoryBucketRead,
+		UpdateWithoutTimeout: resourceDirectoryBucketUpdate,
+		DeleteWithoutTimeout: resourceDirectoryBucketDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceDirectoryBucketCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDirectoryBucketName,
+			},
+			"data_redundancy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      s3.DataRedundancySingleAvailabilityZone,
+				ValidateFunc: validation.StringInSlice(s3.DataRedundancy_Values(), false),
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"location": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      s3.LocationTypeAvailabilityZone,
+							ValidateFunc: validation.StringInSlice(s3.LocationType_Values(), false),
+						},
+					},
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      s3.BucketTypeDirectory,
+				ValidateFunc: validation.StringInSlice(s3.BucketType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDirectoryBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	bucket := d.Get("bucket").(string)
+	input := &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+		CreateBucketConfiguration: &s3.CreateBucketConfiguration{
+			Bucket: &s3.BucketInfo{
+				DataRedundancy: aws.String(d.Get("data_redundancy").(string)),
+				Type:           aws.String(d.Get("type").(string)),
+			},
+			Location: expandDirectoryBucketLocationInfo(d.Get("location").([]interface{})),
+		},
+	}
+
+	_, err := conn.CreateBucketWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Directory Bucket (%s): %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return append(diags, resourceDirectoryBucketRead(ctx, d, meta)...)
+}
+
+func resourceDirectoryBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	_, err := tfresource.RetryWhenNewResourceNotFound(ctx, 2*time.Minute, func() (interface{}, error) {
+		return FindDirectoryBucketByName(ctx, conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Directory Bucket (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Bucket (%s): %s", d.Id(), err)
+	}
+
+	// ListDirectoryBuckets only returns the bucket name.
+	// The location is encoded in the name and the remaining attributes have a single valid value.
+	azID, _ := conns.S3DirectoryBucketAvailabilityZoneID(d.Id())
+
+	d.Set("arn", directoryBucketARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set("bucket", d.Id())
+	if _, ok := d.GetOk("data_redundancy"); !ok {
+		d.Set("data_redundancy", s3.DataRedundancySingleAvailabilityZone)
+	}
+	if err := d.Set("location", []interface{}{map[string]interface{}{
+		"name": azID,
+		"type": s3.LocationTypeAvailabilityZone,
+	}}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting location: %s", err)
+	}
+	if _, ok := d.GetOk("type"); !ok {
+		d.Set("type", s3.BucketTypeDirectory)
+	}
+
+	return diags
+}
+
+func resourceDirectoryBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Only force_destroy can be updated.
+
+	return append(diags, resourceDirectoryBucketRead(ctx, d, meta)...)
+}
+
+func resourceDirectoryBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	log.Printf("[INFO] Deleting S3 Directory Bucket: %s", d.Id())
+	_, err := conn.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeBucketNotEmpty) && d.Get("force_destroy").(bool) {
+		// Directory buckets don't support versioning or object lock, so deleting the current objects empties the bucket.
+		if err := emptyDirectoryBucket(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "emptying S3 Directory Bucket (%s): %s", d.Id(), err)
+		}
+
+		_, err = conn.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{
+			Bucket: aws.String(d.Id()),
+		})
+	}
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Bucket (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceDirectoryBucketCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("bucket") || !d.NewValueKnown("location") {
+		return nil
+	}
+
+	bucket := d.Get("bucket").(string)
+	azID, ok := conns.S3DirectoryBucketAvailabilityZoneID(bucket)
+
+	if !ok {
+		return nil
+	}
+
+	if v, ok := d.Get("location").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if name := v[0].(map[string]interface{})["name"].(string); name != azID {
+			return fmt.Errorf("bucket name (%s) must include the location name (%s) as Availability Zone ID", bucket, name)
+		}
+	}
+
+	return nil
+}
+
+func FindDirectoryBucketByName(ctx context.Context, conn *s3.S3, name string) (*s3.Bucket, error) {
+	input := &s3.ListDirectoryBucketsInput{}
+	var output *s3.Bucket
+
+	err := conn.ListDirectoryBucketsPagesWithContext(ctx, input, func(page *s3.ListDirectoryBucketsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Buckets {
+			if aws.StringValue(v.Name) == name {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// emptyDirectoryBucket deletes all objects in a directory bucket.
+func emptyDirectoryBucket(ctx context.Context, conn *s3.S3, bucket string) error {
+	objects, err := findObjectsByPrefix(ctx, conn, bucket, "")
+
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+
+	return deleteObjectsByKey(ctx, conn, bucket, keys)
+}
+
+func expandDirectoryBucketLocationInfo(tfList []interface{}) *s3.LocationInfo {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &s3.LocationInfo{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func directoryBucketARN(client *conns.AWSClient, bucket string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "s3express",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  "bucket/" + bucket,
+	}.String()
+}
+
+// isDirectoryBucket reports whether the bucket name is the name of an S3 Express One Zone directory bucket.
+func isDirectoryBucket(bucket string) bool {
+	_, ok := conns.S3DirectoryBucketAvailabilityZoneID(bucket)
+
+	return ok
+}
+
+func validateDirectoryBucketName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 3 || len(value) > 63 {
+		errors = append(errors, fmt.Errorf("%q must contain from 3 to 63 characters: %q", k, value))
+	}
+
+	if !isDirectoryBucket(value) {
+		errors = append(errors, fmt.Errorf("%q must be in the format bucket-base-name--azid--x-s3, using lowercase letters, numbers and hyphens: %q", k, value))
+	}
+
+	return
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_s3_directory_buckets", name="Directory Buckets")
+func DataSourceDirectoryBuckets() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDirectoryBucketsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"buckets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDirectoryBucketsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient)
+	conn := client.S3Conn(ctx)
+
+	var arns, buckets []string
+
+	err := conn.ListDirectoryBucketsPagesWithContext(ctx, &s3.ListDirectoryBucketsInput{}, func(page *s3.ListDirectoryBucketsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Buckets {
+			bucket := aws.StringValue(v.Name)
+			arns = append(arns, directoryBucketARN(client, bucket))
+			buckets = append(buckets, bucket)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing S3 Directory Buckets: %s", err)
+	}
+
+	d.SetId(client.Region)
+	d.Set("arns", arns)
+	d.Set("buckets", buckets)
+
+	return diags
+}
@@ -0,0 +1,755 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/mitchellh/go-homedir"
+)
+
+// @SDKResource("aws_s3_directory_sync", name="Directory Sync")
+func ResourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectorySyncCreate,
+		ReadWithoutTimeout:   resourceDirectorySyncRead,
+		UpdateWithoutTimeout: resourceDirectorySyncUpdate,
+		DeleteWithoutTimeout: resourceDirectorySyncDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"delete_extraneous": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateDirectorySyncPattern,
+				},
+			},
+			"include": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateDirectorySyncPattern,
+				},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "",
+			},
+			"manifest_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateDirectorySyncPattern,
+						},
+					},
+				},
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	if err := directorySync(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "syncing S3 Bucket (%s) prefix (%s): %s", bucket, keyPrefix, err)
+	}
+
+	d.SetId(directorySyncCreateResourceID(bucket, keyPrefix))
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	config, err := expandDirectorySyncConfig(d.Get)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// The local files are only listed here, not hashed. The manifest records the remote ETags
+	// and CustomizeDiff compares it with the manifest of the local content.
+	files, err := config.localFiles(false)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		// The local tree is gone (e.g. a different machine), so nothing can be compared.
+		log.Printf("[WARN] Reading S3 Directory Sync (%s) source: %s", d.Id(), err)
+		d.Set("manifest_hash", "")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	objects, err := findObjectsByPrefix(ctx, conn, config.bucket, config.keyPrefix)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	entries := make([]string, 0, len(files))
+	for _, f := range files {
+		object, ok := objects[f.key]
+		if !ok {
+			continue
+		}
+
+		delete(objects, f.key)
+
+		entries = append(entries, f.manifestEntryWithETag(aws.StringValue(object.ETag)))
+	}
+
+	// Without delete_extraneous remote-only objects aren't managed and don't affect the manifest.
+	if d.Get("delete_extraneous").(bool) {
+		for key := range objects {
+			if config.matches(strings.TrimPrefix(key, config.keyPrefix)) {
+				entries = append(entries, "extraneous\x00"+key)
+			}
+		}
+	}
+
+	d.Set("manifest_hash", directorySyncManifestHash(entries))
+
+	return diags
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := directorySync(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "syncing S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	config, err := expandDirectorySyncConfig(d.Get)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	objects, err := findObjectsByPrefix(ctx, conn, config.bucket, config.keyPrefix)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	var keys []string
+
+	if d.Get("delete_extraneous").(bool) {
+		// The resource owns every matching object under the prefix.
+		for key := range objects {
+			if config.matches(strings.TrimPrefix(key, config.keyPrefix)) {
+				keys = append(keys, key)
+			}
+		}
+	} else {
+		files, err := config.localFiles(false)
+
+		if err != nil {
+			log.Printf("[WARN] Unable to determine the objects managed by S3 Directory Sync (%s), leaving them in place: %s", d.Id(), err)
+			return diags
+		}
+
+		for _, f := range files {
+			if _, ok := objects[f.key]; ok {
+				keys = append(keys, f.key)
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting %d S3 objects for S3 Directory Sync (%s)", len(keys), d.Id())
+	if err := deleteObjectsByKey(ctx, conn, config.bucket, keys); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceDirectorySyncCustomizeDiff hashes the local tree at plan time so that any
+// content, metadata rule or file set change shows up as a manifest_hash diff.
+// This is the only place a plan hashes the local files. If source_dir doesn't exist, no diff is planned,
+// consistent with Read.
+func resourceDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"source_dir", "include", "exclude", "rule"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("manifest_hash")
+		}
+	}
+
+	config, err := expandDirectorySyncConfig(d.Get)
+
+	if err != nil {
+		return err
+	}
+
+	files, err := config.localFiles(true)
+
+	if errors.Is(err, fs.ErrNotExist) && d.Id() != "" {
+		log.Printf("[WARN] Planning S3 Directory Sync (%s): %s", d.Id(), err)
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	entries := make([]string, 0, len(files))
+	for _, f := range files {
+		entries = append(entries, f.manifestEntry())
+	}
+
+	if hash := directorySyncManifestHash(entries); hash != d.Get("manifest_hash").(string) {
+		return d.SetNew("manifest_hash", hash)
+	}
+
+	return nil
+}
+
+// directorySync uploads new and changed files concurrently and optionally removes extraneous objects.
+func directorySync(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	config, err := expandDirectorySyncConfig(d.Get)
+
+	if err != nil {
+		return err
+	}
+
+	files, err := config.localFiles(true)
+
+	if err != nil {
+		return err
+	}
+
+	// Object metadata isn't returned by ListObjectsV2, so files whose rules changed are uploaded again.
+	var previous map[string]*directorySyncFile
+	if !d.IsNewResource() && d.HasChange("rule") {
+		oldConfig, err := expandDirectorySyncConfig(func(key string) interface{} {
+			o, _ := d.GetChange(key)
+			return o
+		})
+
+		if err != nil {
+			return err
+		}
+
+		previous = make(map[string]*directorySyncFile)
+		for _, f := range files {
+			contentType, cacheControl := oldConfig.attributes(f.relPath)
+			previous[f.key] = &directorySyncFile{contentType: contentType, cacheControl: cacheControl}
+		}
+	}
+
+	objects, err := findObjectsByPrefix(ctx, conn, config.bucket, config.keyPrefix)
+
+	if err != nil {
+		return err
+	}
+
+	var uploads []*directorySyncFile
+	for _, f := range files {
+		object, ok := objects[f.key]
+		delete(objects, f.key)
+
+		if !ok {
+			uploads = append(uploads, f)
+			continue
+		}
+
+		if p, ok := previous[f.key]; ok && (p.contentType != f.contentType || p.cacheControl != f.cacheControl) {
+			uploads = append(uploads, f)
+			continue
+		}
+
+		// Objects whose ETag differs from the one the uploader produces are uploaded again, even if their
+		// content is the same (e.g. uploaded with a different part size), so that Read's manifest matches the plan's.
+		if strings.Trim(aws.StringValue(object.ETag), `"`) != f.etag || aws.Int64Value(object.Size) != f.size {
+			uploads = append(uploads, f)
+		}
+	}
+
+	var deletes []string
+	if d.Get("delete_extraneous").(bool) {
+		for key := range objects {
+			if config.matches(strings.TrimPrefix(key, config.keyPrefix)) {
+				deletes = append(deletes, key)
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] S3 Directory Sync: uploading %d of %d files and deleting %d objects in S3 Bucket (%s)", len(uploads), len(files), len(deletes), config.bucket)
+
+	if err := uploadDirectorySyncFiles(ctx, conn, config.bucket, uploads, d.Get("max_concurrency").(int)); err != nil {
+		return err
+	}
+
+	return deleteObjectsByKey(ctx, conn, config.bucket, deletes)
+}
+
+func uploadDirectorySyncFiles(ctx context.Context, conn *s3.S3, bucket string, files []*directorySyncFile, maxConcurrency int) error {
+	uploader := s3manager.NewUploaderWithClient(conn)
+
+	var (
+		errs *multierror.Error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	sem := make(chan struct{}, maxConcurrency)
+
+	for _, f := range files {
+		f := f
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := f.upload(ctx, uploader, bucket); err != nil {
+				mu.Lock()
+				errs = multierror.Append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
+
+// deleteObjectsByKey deletes the specified objects in batches of up to 1000 keys.
+func deleteObjectsByKey(ctx context.Context, conn *s3.S3, bucket string, keys []string) error {
+	const batchSize = 1000
+	var errs *multierror.Error
+
+	for i := 0; i < len(keys); i += batchSize {
+		j := i + batchSize
+		if j > len(keys) {
+			j = len(keys)
+		}
+		batch := keys[i:j]
+		objects := make([]*s3.ObjectIdentifier, 0, len(batch))
+		for _, key := range batch {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		}
+
+		output, err := conn.DeleteObjectsWithContext(ctx, input)
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("deleting S3 objects from S3 Bucket (%s): %w", bucket, err))
+			continue
+		}
+
+		for _, v := range output.Errors {
+			errs = multierror.Append(errs, fmt.Errorf("deleting S3 object (%s) from S3 Bucket (%s): %s: %s", aws.StringValue(v.Key), bucket, aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func findObjectsByPrefix(ctx context.Context, conn *s3.S3, bucket, prefix string) (map[string]*s3.Object, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	objects := make(map[string]*s3.Object)
+
+	err := conn.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Contents {
+			if v != nil {
+				objects[aws.StringValue(v.Key)] = v
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("listing S3 Bucket (%s) objects: %w", bucket, err)
+	}
+
+	return objects, nil
+}
+
+func directorySyncCreateResourceID(bucket, keyPrefix string) string {
+	return bucket + "/" + keyPrefix
+}
+
+type directorySyncRule struct {
+	cacheControl string
+	contentType  string
+	pattern      string
+}
+
+type directorySyncConfig struct {
+	bucket    string
+	exclude   []string
+	include   []string
+	keyPrefix string
+	rules     []directorySyncRule
+	sourceDir string
+}
+
+func expandDirectorySyncConfig(get func(string) interface{}) (*directorySyncConfig, error) {
+	sourceDir, err := homedir.Expand(get("source_dir").(string))
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_dir: %w", err)
+	}
+
+	config := &directorySyncConfig{
+		bucket:    get("bucket").(string),
+		keyPrefix: get("key_prefix").(string),
+		sourceDir: sourceDir,
+	}
+
+	for _, v := range get("include").([]interface{}) {
+		if v, ok := v.(string); ok {
+			config.include = append(config.include, v)
+		}
+	}
+
+	for _, v := range get("exclude").([]interface{}) {
+		if v, ok := v.(string); ok {
+			config.exclude = append(config.exclude, v)
+		}
+	}
+
+	for _, v := range get("rule").([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		config.rules = append(config.rules, directorySyncRule{
+			cacheControl: tfMap["cache_control"].(string),
+			contentType:  tfMap["content_type"].(string),
+			pattern:      tfMap["pattern"].(string),
+		})
+	}
+
+	return config, nil
+}
+
+// matches reports whether a slash-separated path relative to the source directory is selected by the include and exclude patterns.
+func (c *directorySyncConfig) matches(relPath string) bool {
+	included := len(c.include) == 0
+	for _, pattern := range c.include {
+		if matchDirectorySyncPattern(pattern, relPath) {
+			included = true
+			break
+		}
+	}
+
+	if !included {
+		return false
+	}
+
+	for _, pattern := range c.exclude {
+		if matchDirectorySyncPattern(pattern, relPath) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// attributes returns the Content-Type and Cache-Control for a file.
+// The Content-Type defaults to the one registered for the file extension; matching rules are applied in order, so later rules win.
+func (c *directorySyncConfig) attributes(relPath string) (string, string) {
+	contentType := mime.TypeByExtension(path.Ext(relPath))
+	var cacheControl string
+
+	for _, rule := range c.rules {
+		if !matchDirectorySyncPattern(rule.pattern, relPath) {
+			continue
+		}
+
+		if rule.contentType != "" {
+			contentType = rule.contentType
+		}
+		if rule.cacheControl != "" {
+			cacheControl = rule.cacheControl
+		}
+	}
+
+	return contentType, cacheControl
+}
+
+// localFiles returns the selected files in the source directory.
+// The files' ETags are only computed if withETags is set, as that reads their content.
+func (c *directorySyncConfig) localFiles(withETags bool) ([]*directorySyncFile, error) {
+	var files []*directorySyncFile
+
+	err := filepath.WalkDir(c.sourceDir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(c.sourceDir, p)
+
+		if err != nil {
+			return err
+		}
+
+		relPath := filepath.ToSlash(rel)
+
+		if !c.matches(relPath) {
+			return nil
+		}
+
+		info, err := entry.Info()
+
+		if err != nil {
+			return err
+		}
+
+		f := &directorySyncFile{
+			key:     c.keyPrefix + relPath,
+			path:    p,
+			relPath: relPath,
+			size:    info.Size(),
+		}
+		f.contentType, f.cacheControl = c.attributes(relPath)
+
+		if withETags {
+			if f.etag, err = objectETag(p, f.size, uploadPartSize(f.size, 0)); err != nil {
+				return err
+			}
+		}
+
+		files = append(files, f)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", c.sourceDir, err)
+	}
+
+	return files, nil
+}
+
+type directorySyncFile struct {
+	cacheControl string
+	contentType  string
+	etag         string // The ETag that S3 computes when the s3manager uploader uploads the file.
+	key          string
+	path         string
+	relPath      string
+	size         int64
+}
+
+func (f *directorySyncFile) manifestEntry() string {
+	return f.manifestEntryWithETag(f.etag)
+}
+
+func (f *directorySyncFile) manifestEntryWithETag(etag string) string {
+	return strings.Join([]string{f.key, strings.Trim(etag, `"`), f.contentType, f.cacheControl}, "\x00")
+}
+
+func (f *directorySyncFile) upload(ctx context.Context, uploader *s3manager.Uploader, bucket string) error {
+	file, err := os.Open(f.path)
+
+	if err != nil {
+		return fmt.Errorf("opening S3 Directory Sync source (%s): %w", f.path, err)
+	}
+
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf("[WARN] Error closing S3 Directory Sync source (%s): %s", f.path, err)
+		}
+	}()
+
+	input := &s3manager.UploadInput{
+		Body:   file,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(f.key),
+	}
+
+	if f.cacheControl != "" {
+		input.CacheControl = aws.String(f.cacheControl)
+	}
+
+	if f.contentType != "" {
+		input.ContentType = aws.String(f.contentType)
+	}
+
+	if _, err := uploader.UploadWithContext(ctx, input); err != nil {
+		return fmt.Errorf("uploading S3 object (%s): %w", f.key, err)
+	}
+
+	return nil
+}
+
+func directorySyncManifestHash(entries []string) string {
+	sort.Strings(entries)
+
+	h := sha256.New()
+	for _, entry := range entries {
+		h.Write([]byte(entry))
+		h.Write([]byte{'\n'})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// matchDirectorySyncPattern matches a slash-separated path against a glob pattern.
+// In addition to the path.Match syntax a "**" segment matches zero or more directories.
+func matchDirectorySyncPattern(pattern, name string) bool {
+	return matchDirectorySyncSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchDirectorySyncSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchDirectorySyncSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
+
+func validateDirectorySyncPattern(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	for _, segment := range strings.Split(value, "/") {
+		if segment == "**" {
+			continue
+		}
+
+		if _, err := path.Match(segment, ""); err != nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid pattern (%s): %w", k, value, err))
+			break
+		}
+	}
+
+	return
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"testing"
+)
+
+func TestMatchDirectorySyncPattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"*.html", "index.html", true},
+		{"*.html", "docs/index.html", false},
+		{"**/*.html", "index.html", true},
+		{"**/*.html", "docs/guide/index.html", true},
+		{"docs/**", "docs/guide/index.html", true},
+		{"docs/**", "assets/app.js", false},
+		{"assets/*.js", "assets/app.js", true},
+		{"assets/*.js", "assets/vendor/lib.js", false},
+		{"assets/**/*.map", "assets/vendor/lib.js.map", true},
+		{"**", "a/b/c", true},
+	}
+
+	for _, testCase := range testCases {
+		if got := matchDirectorySyncPattern(testCase.pattern, testCase.name); got != testCase.want {
+			t.Errorf("matchDirectorySyncPattern(%q, %q) = %t, want %t", testCase.pattern, testCase.name, got, testCase.want)
+		}
+	}
+}
+
+func TestDirectorySyncConfigAttributes(t *testing.T) {
+	t.Parallel()
+
+	config := &directorySyncConfig{
+		rules: []directorySyncRule{
+			{pattern: "**", cacheControl: "max-age=3600"},
+			{pattern: "**/*.html", cacheControl: "no-cache"},
+			{pattern: "**/*.wasm", contentType: "application/wasm"},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		wantContentType  string
+		wantCacheControl string
+	}{
+		{"index.html", "text/html; charset=utf-8", "no-cache"},
+		{"app/module.wasm", "application/wasm", "max-age=3600"},
+		{"data/blob", "", "max-age=3600"},
+	}
+
+	for _, testCase := range testCases {
+		contentType, cacheControl := config.attributes(testCase.name)
+
+		if contentType != testCase.wantContentType {
+			t.Errorf("content type for %q = %q, want %q", testCase.name, contentType, testCase.wantContentType)
+		}
+
+		if cacheControl != testCase.wantCacheControl {
+			t.Errorf("cache control for %q = %q, want %q", testCase.name, cacheControl, testCase.wantCacheControl)
+		}
+	}
+}
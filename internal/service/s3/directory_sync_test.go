@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := testAccDirectorySyncCreateTree(t, map[string]string{
+		"index.html":         "<html></html>",
+		"assets/app.js":      "console.log(1);",
+		"assets/app.js.map":  "{}",
+		"docs/guide/a.html":  "<p>a</p>",
+		"docs/guide/b.json":  "{}",
+		"private/secret.txt": "secret",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_hash"),
+					resource.TestCheckResourceAttr(resourceName, "delete_extraneous", "false"),
+					testAccCheckDirectorySyncObjects(ctx, rName, "site/", map[string]string{
+						"site/index.html":        "text/html; charset=utf-8",
+						"site/assets/app.js":     "text/javascript; charset=utf-8",
+						"site/docs/guide/a.html": "text/html; charset=utf-8",
+						"site/docs/guide/b.json": "application/json",
+					}),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(filepath.Join(dir, "index.html"), []byte("<html>updated</html>"), 0600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccDirectorySyncConfig_basic(rName, dir),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_hash"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteExtraneous(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := testAccDirectorySyncCreateTree(t, map[string]string{
+		"index.html": "<html></html>",
+		"error.html": "<html>error</html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_deleteExtraneous(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, rName, "", map[string]string{
+						"index.html": "text/html; charset=utf-8",
+						"error.html": "text/html; charset=utf-8",
+					}),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn(ctx)
+
+					for _, key := range []string{"stale.html", "data.json"} {
+						_, err := conn.PutObjectWithContext(ctx, &s3.PutObjectInput{
+							Body:        strings.NewReader("stale"),
+							Bucket:      aws.String(rName),
+							ContentType: aws.String("text/html"),
+							Key:         aws.String(key),
+						})
+
+						if err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				Config: testAccDirectorySyncConfig_deleteExtraneous(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					// Objects that don't match the include patterns aren't managed.
+					testAccCheckDirectorySyncObjects(ctx, rName, "", map[string]string{
+						"index.html": "text/html; charset=utf-8",
+						"error.html": "text/html; charset=utf-8",
+						"data.json":  "text/html",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDirectorySyncCreateTree(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+// testAccCheckDirectorySyncObjects verifies that exactly the expected objects exist under the prefix with the expected Content-Type.
+func testAccCheckDirectorySyncObjects(ctx context.Context, bucket, prefix string, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn(ctx)
+
+		input := &s3.ListObjectsV2Input{
+			Bucket: aws.String(bucket),
+			Prefix: aws.String(prefix),
+		}
+		var keys []string
+
+		err := conn.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+			for _, v := range page.Contents {
+				keys = append(keys, aws.StringValue(v.Key))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(keys) != len(expected) {
+			return fmt.Errorf("expected %d objects under s3://%s/%s, got %d: %v", len(expected), bucket, prefix, len(keys), keys)
+		}
+
+		for _, key := range keys {
+			contentType, ok := expected[key]
+			if !ok {
+				return fmt.Errorf("unexpected object s3://%s/%s", bucket, key)
+			}
+
+			output, err := conn.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String(key),
+			})
+
+			if err != nil {
+				return err
+			}
+
+			if got := aws.StringValue(output.ContentType); got != contentType {
+				return fmt.Errorf("object s3://%s/%s Content-Type = %q, want %q", bucket, key, got, contentType)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectorySyncConfig_basic(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[2]q
+
+  exclude = ["private/**", "**/*.map"]
+
+  rule {
+    pattern       = "**"
+    cache_control = "max-age=3600"
+  }
+
+  rule {
+    pattern       = "**/*.html"
+    cache_control = "no-cache"
+  }
+
+  rule {
+    pattern      = "**/*.js"
+    content_type = "text/javascript; charset=utf-8"
+  }
+}
+`, rName, dir)
+}
+
+func testAccDirectorySyncConfig_deleteExtraneous(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket            = aws_s3_bucket.test.bucket
+  source_dir        = %[2]q
+  include           = ["*.html"]
+  delete_extraneous = true
+}
+`, rName, dir)
+}
@@ -26,6 +26,7 @@ const (
 	// Reference: https://github.com/hashicorp/terraform-provider-aws/pull/26317
 	errCodeObjectLockConfigurationNotFound           = "ObjectLockConfigurationNotFound"
 	errCodeOperationAborted                          = "OperationAborted"
+	errCodeOwnershipControlsNotFoundError            = "OwnershipControlsNotFoundError"
 	ErrCodeReplicationConfigurationNotFound          = "ReplicationConfigurationNotFoundError"
 	ErrCodeServerSideEncryptionConfigurationNotFound = "ServerSideEncryptionConfigurationNotFoundError"
 	errCodeUnsupportedArgument                       = "UnsupportedArgument"
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+func TestUploadPartSize(t *testing.T) {
+	t.Parallel()
+
+	const mib = 1024 * 1024
+
+	testCases := []struct {
+		size     int64
+		partSize int64
+		want     int64
+	}{
+		{10 * mib, 0, s3manager.DefaultUploadPartSize},
+		{10 * mib, 16 * mib, 16 * mib},
+		{100000 * mib, 0, (100000*mib)/s3manager.MaxUploadParts + 1},
+		{100000 * mib, 8 * mib, (100000*mib)/s3manager.MaxUploadParts + 1},
+		{100000 * mib, 16 * mib, 16 * mib},
+	}
+
+	for _, testCase := range testCases {
+		if got := uploadPartSize(testCase.size, testCase.partSize); got != testCase.want {
+			t.Errorf("uploadPartSize(%d, %d) = %d, want %d", testCase.size, testCase.partSize, got, testCase.want)
+		}
+	}
+}
+
+func TestObjectETag(t *testing.T) {
+	t.Parallel()
+
+	const (
+		mib  = 1024 * 1024
+		size = 11 * mib
+	)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "object")
+
+	if err := os.WriteFile(path, bytes.Repeat([]byte("a"), size), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	md5ETag, err := objectETag(path, size, 16*mib)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(md5ETag) != 32 || strings.Contains(md5ETag, "-") {
+		t.Errorf("single part ETag = %q, want an MD5 hex digest", md5ETag)
+	}
+
+	// Parts of 5, 5 and 1 MiB, as uploaded by the s3manager defaults.
+	sdkETag, err := objectETag(path, size, 5*mib)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(sdkETag, "-3") {
+		t.Errorf("multipart ETag = %q, want suffix %q", sdkETag, "-3")
+	}
+
+	// Parts of 8 and 3 MiB, as uploaded by the AWS CLI defaults.
+	cliETag, err := objectETag(path, size, 8*mib)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(cliETag, "-2") {
+		t.Errorf("multipart ETag = %q, want suffix %q", cliETag, "-2")
+	}
+
+	// Parts of 6 and 5 MiB, as uploaded with a custom part size.
+	customETag, err := objectETag(path, size, 6*mib)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name       string
+		etag       string
+		objectSize int64
+		partSizes  []int64
+		want       bool
+	}{
+		{"single part", md5ETag, size, nil, true},
+		{"s3manager defaults", `"` + sdkETag + `"`, size, nil, true},
+		{"AWS CLI defaults", `"` + cliETag + `"`, size, nil, true},
+		{"custom part size", customETag, size, []int64{6 * mib}, true},
+		{"size mismatch", cliETag, size + 1, nil, false},
+		{"content mismatch", "0123456789abcdef0123456789abcdef-2", size, nil, false},
+		{"invalid part count", "0123456789abcdef0123456789abcdef-x", size, nil, false},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fileMatchesETag(path, size, testCase.etag, testCase.objectSize, testCase.partSizes...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != testCase.want {
+				t.Errorf("fileMatchesETag(%q) = %t, want %t", testCase.etag, got, testCase.want)
+			}
+		})
+	}
+}
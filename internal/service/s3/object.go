@@ -77,6 +77,28 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -104,10 +126,16 @@ func ResourceObject() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"detect_source_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption. The Etag then won't match raw-file MD5.
+				// Objects uploaded in multiple parts have an Etag derived from the MD5 of each part,
+				// which Read reconciles with a configured raw-file MD5.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
 				Optional:      true,
 				Computed:      true,
@@ -181,6 +209,16 @@ func ResourceObject() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+			"upload_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -204,8 +242,14 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
+	input := &s3.HeadObjectInput{
+		Bucket:       aws.String(bucket),
+		Key:          aws.String(key),
+		ChecksumMode: aws.String(s3.ChecksumModeEnabled),
+	}
+
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, objectCreationTimeout, func() (interface{}, error) {
-		return FindObjectByThreePartKey(ctx, conn, bucket, key, "")
+		return findObject(ctx, conn, input)
 	}, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -222,6 +266,11 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.Set("bucket_key_enabled", output.BucketKeyEnabled)
 	d.Set("cache_control", output.CacheControl)
+	d.Set("checksum_algorithm", objectChecksumAlgorithm(output))
+	d.Set("checksum_crc32", output.ChecksumCRC32)
+	d.Set("checksum_crc32c", output.ChecksumCRC32C)
+	d.Set("checksum_sha1", output.ChecksumSHA1)
+	d.Set("checksum_sha256", output.ChecksumSHA256)
 	d.Set("content_disposition", output.ContentDisposition)
 	d.Set("content_encoding", output.ContentEncoding)
 	d.Set("content_language", output.ContentLanguage)
@@ -249,7 +298,12 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
-	d.Set("etag", strings.Trim(aws.StringValue(output.ETag), `"`))
+	etag := strings.Trim(aws.StringValue(output.ETag), `"`)
+	// Keep a configured raw-file MD5 if the source was uploaded in multiple parts and is unchanged.
+	if v := d.Get("etag").(string); v != etag && objectSourceMatchesMultipartETag(d, v, etag, aws.Int64Value(output.ContentLength)) {
+		etag = v
+	}
+	d.Set("etag", etag)
 
 	// The "STANDARD" (which is also the default) storage
 	// class when set would not be included in the results.
@@ -259,6 +313,11 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 		d.Set("storage_class", output.StorageClass)
 	}
 
+	// Directory buckets don't support object tags.
+	if isDirectoryBucket(bucket) {
+		return diags
+	}
+
 	// Retry due to S3 eventual consistency
 	tagsRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
 		return ObjectListTags(ctx, conn, bucket, key)
@@ -340,7 +399,7 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("tags_all") {
+	if d.HasChange("tags_all") && !isDirectoryBucket(bucket) {
 		o, n := d.GetChange("tags_all")
 
 		if err := ObjectUpdateTags(ctx, conn, bucket, key, o, n); err != nil {
@@ -398,7 +457,15 @@ func resourceObjectImport(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Conn(ctx)
-	uploader := s3manager.NewUploaderWithClient(conn)
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		if v, ok := d.GetOk("upload_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+
+		if v, ok := d.GetOk("upload_part_size"); ok {
+			u.PartSize = int64(v.(int))
+		}
+	})
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
@@ -459,6 +526,10 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.CacheControl = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumAlgorithm = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("content_type"); ok {
 		input.ContentType = aws.String(v.(string))
 	}
@@ -492,7 +563,7 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
 	}
 
-	if len(tags) > 0 {
+	if len(tags) > 0 && !isDirectoryBucket(bucket) {
 		// The tag-set must be encoded as URL Query parameters.
 		input.Tagging = aws.String(tags.IgnoreAWS().URLEncode())
 	}
@@ -513,7 +584,7 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ObjectLockRetainUntilDate = expandObjectDate(v.(string))
 	}
 
-	if _, err := uploader.Upload(input); err != nil {
+	if _, err := uploader.UploadWithContext(ctx, input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading object to S3 bucket (%s): %s", bucket, err)
 	}
 
@@ -555,21 +626,137 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	if hasObjectContentChanges(d) {
+		if d.Id() != "" && !objectETagConfigured(d) {
+			if err := d.SetNewComputed("etag"); err != nil {
+				return err
+			}
+		}
+
 		return d.SetNewComputed("version_id")
 	}
 
 	if d.HasChange("source_hash") {
 		d.SetNewComputed("version_id")
 		d.SetNewComputed("etag")
+
+		return nil
+	}
+
+	changed, err := objectSourceChanged(d)
+
+	if err != nil {
+		return err
+	}
+
+	if changed {
+		d.SetNewComputed("version_id")
+		d.SetNewComputed("etag")
 	}
 
 	return nil
 }
 
+func objectETagConfigured(d *schema.ResourceDiff) bool {
+	rawConfig := d.GetRawConfig()
+
+	return rawConfig.IsNull() || !rawConfig.GetAttr("etag").IsNull()
+}
+
+// objectSourceChanged reports whether the content of the local source file no longer matches the object's ETag.
+// The check only runs if detect_source_changes is enabled and is skipped if either etag or source_hash is configured,
+// as those already drive the diff.
+func objectSourceChanged(d *schema.ResourceDiff) (bool, error) {
+	if d.Id() == "" || !d.Get("detect_source_changes").(bool) || objectETagConfigured(d) || !d.GetRawConfig().GetAttr("source_hash").IsNull() {
+		return false, nil
+	}
+
+	source := d.Get("source").(string)
+	etag := d.Get("etag").(string)
+
+	if source == "" || etag == "" || !d.NewValueKnown("source") {
+		return false, nil
+	}
+
+	// SSE-KMS encrypted objects and objects in directory buckets don't have MD5-based ETags.
+	if v := d.Get("server_side_encryption").(string); strings.HasPrefix(v, s3.ServerSideEncryptionAwsKms) || isDirectoryBucket(d.Get("bucket").(string)) {
+		return false, nil
+	}
+
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return false, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		// Any error is reported when the object is uploaded.
+		return false, nil
+	}
+
+	size := info.Size()
+	match, err := fileMatchesETag(path, size, etag, size, uploadPartSize(size, int64(d.Get("upload_part_size").(int))))
+	if err != nil {
+		return false, fmt.Errorf("reading S3 object source (%s): %w", path, err)
+	}
+
+	return !match, nil
+}
+
+// objectSourceMatchesMultipartETag reports whether md5 is the MD5 of the local source file and
+// the file's content matches the multipart ETag of the uploaded object.
+func objectSourceMatchesMultipartETag(d *schema.ResourceData, md5, etag string, objectSize int64) bool {
+	source := d.Get("source").(string)
+
+	if source == "" || len(md5) != 32 || !strings.Contains(etag, "-") {
+		return false
+	}
+
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return false
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+
+	size := info.Size()
+
+	if v, err := objectETag(path, size, size); err != nil || v != md5 {
+		return false
+	}
+
+	match, err := fileMatchesETag(path, size, etag, objectSize, uploadPartSize(size, int64(d.Get("upload_part_size").(int))))
+	if err != nil {
+		log.Printf("[WARN] Error reading S3 object source (%s): %s", path, err)
+		return false
+	}
+
+	return match
+}
+
+// objectChecksumAlgorithm returns the algorithm of the checksum stored with the object, if any.
+func objectChecksumAlgorithm(output *s3.HeadObjectOutput) string {
+	switch {
+	case output.ChecksumCRC32 != nil:
+		return s3.ChecksumAlgorithmCrc32
+	case output.ChecksumCRC32C != nil:
+		return s3.ChecksumAlgorithmCrc32c
+	case output.ChecksumSHA1 != nil:
+		return s3.ChecksumAlgorithmSha1
+	case output.ChecksumSHA256 != nil:
+		return s3.ChecksumAlgorithmSha256
+	default:
+		return ""
+	}
+}
+
 func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
@@ -798,6 +985,10 @@ func FindObjectByThreePartKey(ctx context.Context, conn *s3.S3, bucket, key, eta
 		input.IfMatch = aws.String(etag)
 	}
 
+	return findObject(ctx, conn, input)
+}
+
+func findObject(ctx context.Context, conn *s3.S3, input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
 	output, err := conn.HeadObjectWithContext(ctx, input)
 
 	if tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
@@ -11,6 +11,7 @@ import (
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	s3_sdkv1 "github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 // NewConn returns a new AWS SDK for Go v1 client for this service package's AWS API.
@@ -21,7 +22,14 @@ func (p *servicePackage) NewConn(ctx context.Context, m map[string]any) (*s3_sdk
 		S3ForcePathStyle: aws_sdkv1.Bool(m["s3_use_path_style"].(bool)),
 	}
 
-	return s3_sdkv1.New(sess.Copy(config)), nil
+	conn := s3_sdkv1.New(sess.Copy(config))
+
+	// Directory buckets are only reachable through the AWS endpoints.
+	if m["endpoint"].(string) == "" {
+		conns.RegisterS3ExpressHandlers(conn, m["dns_suffix"].(string))
+	}
+
+	return conn, nil
 }
 
 // CustomizeConn customizes a new AWS SDK for Go v1 client for this service package's AWS API.
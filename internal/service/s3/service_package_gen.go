@@ -30,6 +30,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceBucket,
 			TypeName: "aws_s3_bucket",
 		},
+		{
+			Factory:  dataSourceBucketConfiguration,
+			TypeName: "aws_s3_bucket_configuration",
+			Name:     "Bucket Configuration",
+		},
 		{
 			Factory:  DataSourceBucketObject,
 			TypeName: "aws_s3_bucket_object",
@@ -42,6 +47,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceBucketPolicy,
 			TypeName: "aws_s3_bucket_policy",
 		},
+		{
+			Factory:  DataSourceDirectoryBuckets,
+			TypeName: "aws_s3_directory_buckets",
+			Name:     "Directory Buckets",
+		},
 		{
 			Factory:  DataSourceObject,
 			TypeName: "aws_s3_object",
@@ -143,6 +153,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceBucketWebsiteConfiguration,
 			TypeName: "aws_s3_bucket_website_configuration",
 		},
+		{
+			Factory:  ResourceDirectoryBucket,
+			TypeName: "aws_s3_directory_bucket",
+			Name:     "Directory Bucket",
+		},
+		{
+			Factory:  ResourceDirectorySync,
+			TypeName: "aws_s3_directory_sync",
+			Name:     "Directory Sync",
+		},
 		{
 			Factory:  ResourceObject,
 			TypeName: "aws_s3_object",
@@ -8,23 +8,15 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func lifecycleConfigurationRulesStatus(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string, rules []*s3.LifecycleRule) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		input := &s3.GetBucketLifecycleConfigurationInput{
-			Bucket: aws.String(bucket),
-		}
-
-		if expectedBucketOwner != "" {
-			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
-		}
-
-		output, err := conn.GetBucketLifecycleConfigurationWithContext(ctx, input)
+		output, err := findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
 
-		if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchLifecycleConfiguration, s3.ErrCodeNoSuchBucket) {
+		if tfresource.NotFound(err) {
 			return nil, "", nil
 		}
 
@@ -32,13 +24,6 @@ func lifecycleConfigurationRulesStatus(ctx context.Context, conn *s3.S3, bucket,
 			return nil, "", err
 		}
 
-		if output == nil {
-			return nil, "", &retry.NotFoundError{
-				Message:     "Empty result",
-				LastRequest: input,
-			}
-		}
-
 		for _, expectedRule := range rules {
 			found := false
 
@@ -63,17 +48,9 @@ func lifecycleConfigurationRulesStatus(ctx context.Context, conn *s3.S3, bucket,
 
 func bucketVersioningStatus(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		input := &s3.GetBucketVersioningInput{
-			Bucket: aws.String(bucket),
-		}
-
-		if expectedBucketOwner != "" {
-			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
-		}
-
-		output, err := conn.GetBucketVersioningWithContext(ctx, input)
+		output, err := findBucketVersioning(ctx, conn, bucket, expectedBucketOwner)
 
-		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		if tfresource.NotFound(err) {
 			return nil, "", nil
 		}
 
@@ -81,13 +58,6 @@ func bucketVersioningStatus(ctx context.Context, conn *s3.S3, bucket, expectedBu
 			return nil, "", err
 		}
 
-		if output == nil {
-			return nil, "", &retry.NotFoundError{
-				Message:     "Empty result",
-				LastRequest: input,
-			}
-		}
-
 		if output.Status == nil {
 			return output, BucketVersioningStatusDisabled, nil
 		}
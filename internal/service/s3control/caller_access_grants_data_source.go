@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_s3control_caller_access_grants", name="Caller Access Grants")
+func dataSourceCallerAccessGrants() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCallerAccessGrantsRead,
+
+		Schema: map[string]*schema.Schema{
+			"access_grants": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_grant_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_grant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_grants_location_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"grant_scope": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permission": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"access_grants_instance_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_grants_instance_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"grantee": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grantee_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"grantee_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3control.GranteeType_Values(), false),
+						},
+					},
+				},
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3control.Permission_Values(), false),
+			},
+			"s3_prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^s3://`), "must be in the format s3://bucket/prefix"),
+			},
+		},
+	}
+}
+
+func dataSourceCallerAccessGrantsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient)
+	conn := client.S3ControlConn(ctx)
+
+	s3Prefix := d.Get("s3_prefix").(string)
+	instance, err := findAccessGrantsInstanceForPrefix(ctx, conn, client.AccountID, s3Prefix)
+
+	if err != nil {
+		return diag.Errorf("reading S3 Access Grants Instance for prefix (%s): %s", s3Prefix, err)
+	}
+
+	// The instance may belong to another account that shares it with a resource policy.
+	instanceARN := aws.StringValue(instance.AccessGrantsInstanceArn)
+	accountID := client.AccountID
+	if v, err := arn.Parse(instanceARN); err == nil {
+		accountID = v.AccountID
+	}
+
+	var grantee *s3control.Grantee
+	if v, ok := d.GetOk("grantee"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		grantee = expandGrantee(v.([]interface{})[0].(map[string]interface{}))
+	} else {
+		principalARN, err := callerPrincipalARN(ctx, client)
+
+		if err != nil {
+			return diag.Errorf("reading caller identity: %s", err)
+		}
+
+		grantee = &s3control.Grantee{
+			GranteeIdentifier: aws.String(principalARN),
+			GranteeType:       aws.String(s3control.GranteeTypeIam),
+		}
+	}
+
+	input := &s3control.ListAccessGrantsInput{
+		AccountId:         aws.String(accountID),
+		GranteeIdentifier: grantee.GranteeIdentifier,
+		GranteeType:       grantee.GranteeType,
+	}
+
+	grants, err := findAccessGrants(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("listing S3 Access Grants (%s): %s", accountID, err)
+	}
+
+	permission := d.Get("permission").(string)
+	var tfList []interface{}
+
+	for _, v := range grants {
+		if !accessGrantScopeMatchesPrefix(aws.StringValue(v.GrantScope), s3Prefix) {
+			continue
+		}
+
+		if !accessGrantPermissionSatisfies(aws.StringValue(v.Permission), permission) {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"access_grant_arn":          aws.StringValue(v.AccessGrantArn),
+			"access_grant_id":           aws.StringValue(v.AccessGrantId),
+			"access_grants_location_id": aws.StringValue(v.AccessGrantsLocationId),
+			"grant_scope":               aws.StringValue(v.GrantScope),
+			"permission":                aws.StringValue(v.Permission),
+		})
+	}
+
+	d.SetId(instanceARN)
+	if err := d.Set("access_grants", tfList); err != nil {
+		return diag.Errorf("setting access_grants: %s", err)
+	}
+	d.Set("access_grants_instance_arn", instanceARN)
+	d.Set("access_grants_instance_id", instance.AccessGrantsInstanceId)
+	if err := d.Set("grantee", []interface{}{flattenGrantee(grantee)}); err != nil {
+		return diag.Errorf("setting grantee: %s", err)
+	}
+
+	return nil
+}
+
+// callerPrincipalARN returns the ARN of the IAM principal that S3 Access Grants matches grants against.
+// For an assumed role session that is the ARN of the role, not of the session.
+func callerPrincipalARN(ctx context.Context, client *conns.AWSClient) (string, error) {
+	output, err := tfsts.FindCallerIdentity(ctx, client.STSConn(ctx))
+
+	if err != nil {
+		return "", err
+	}
+
+	callerARN := aws.StringValue(output.Arn)
+	roleName, _ := tfiam.RoleNameSessionFromARN(callerARN)
+
+	if roleName == "" {
+		return callerARN, nil
+	}
+
+	role, err := tfiam.FindRoleByName(ctx, client.IAMConn(ctx), roleName)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(role.Arn), nil
+}
+
+func findAccessGrantsInstanceForPrefix(ctx context.Context, conn *s3control.S3Control, accountID, s3Prefix string) (*s3control.GetAccessGrantsInstanceForPrefixOutput, error) {
+	input := &s3control.GetAccessGrantsInstanceForPrefixInput{
+		AccountId: aws.String(accountID),
+		S3Prefix:  aws.String(s3Prefix),
+	}
+
+	output, err := conn.GetAccessGrantsInstanceForPrefixWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccessGrantsInstanceArn == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findAccessGrants(ctx context.Context, conn *s3control.S3Control, input *s3control.ListAccessGrantsInput) ([]*s3control.ListAccessGrantEntry, error) {
+	var output []*s3control.ListAccessGrantEntry
+
+	err := conn.ListAccessGrantsPagesWithContext(ctx, input, func(page *s3control.ListAccessGrantsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AccessGrantsList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// accessGrantScopeMatchesPrefix returns whether a grant with the specified scope covers the S3 prefix.
+// Scopes ending in '*' cover every prefix that starts with the rest of the scope; other scopes cover a single object.
+func accessGrantScopeMatchesPrefix(scope, prefix string) bool {
+	if v, ok := strings.CutSuffix(scope, "*"); ok {
+		return strings.HasPrefix(strings.TrimSuffix(prefix, "*"), v)
+	}
+
+	return scope == prefix
+}
+
+// accessGrantPermissionSatisfies returns whether a grant with the specified permission allows the requested permission.
+// An empty requested permission is satisfied by any grant.
+func accessGrantPermissionSatisfies(granted, requested string) bool {
+	switch {
+	case requested == "", granted == requested:
+		return true
+	case granted == s3control.PermissionReadwrite:
+		return requested == s3control.PermissionRead || requested == s3control.PermissionWrite
+	default:
+		return false
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"testing"
+)
+
+func TestAccessGrantScopeMatchesPrefix(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Scope    string
+		Prefix   string
+		Expected bool
+	}{
+		{Scope: "s3://*", Prefix: "s3://bucket/prefix/", Expected: true},
+		{Scope: "s3://bucket/*", Prefix: "s3://bucket/prefix/", Expected: true},
+		{Scope: "s3://bucket/prefix/*", Prefix: "s3://bucket/prefix/", Expected: true},
+		{Scope: "s3://bucket/prefix/*", Prefix: "s3://bucket/prefix/*", Expected: true},
+		{Scope: "s3://bucket/prefix/*", Prefix: "s3://bucket/prefix/sub/object", Expected: true},
+		{Scope: "s3://bucket/prefix*", Prefix: "s3://bucket/prefix2/", Expected: true},
+		{Scope: "s3://bucket/prefix/*", Prefix: "s3://bucket/other/"},
+		{Scope: "s3://bucket/prefix/*", Prefix: "s3://bucket2/prefix/"},
+		{Scope: "s3://bucket/prefix/object", Prefix: "s3://bucket/prefix/object", Expected: true},
+		{Scope: "s3://bucket/prefix/object", Prefix: "s3://bucket/prefix/"},
+	}
+
+	for _, testCase := range testCases {
+		if got := accessGrantScopeMatchesPrefix(testCase.Scope, testCase.Prefix); got != testCase.Expected {
+			t.Errorf("accessGrantScopeMatchesPrefix(%q, %q) = %t, expected %t", testCase.Scope, testCase.Prefix, got, testCase.Expected)
+		}
+	}
+}
+
+func TestAccessGrantPermissionSatisfies(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Granted   string
+		Requested string
+		Expected  bool
+	}{
+		{Granted: "READ", Requested: "", Expected: true},
+		{Granted: "READ", Requested: "READ", Expected: true},
+		{Granted: "READ", Requested: "WRITE"},
+		{Granted: "READ", Requested: "READWRITE"},
+		{Granted: "WRITE", Requested: "WRITE", Expected: true},
+		{Granted: "READWRITE", Requested: "READ", Expected: true},
+		{Granted: "READWRITE", Requested: "WRITE", Expected: true},
+		{Granted: "READWRITE", Requested: "READWRITE", Expected: true},
+	}
+
+	for _, testCase := range testCases {
+		if got := accessGrantPermissionSatisfies(testCase.Granted, testCase.Requested); got != testCase.Expected {
+			t.Errorf("accessGrantPermissionSatisfies(%q, %q) = %t, expected %t", testCase.Granted, testCase.Requested, got, testCase.Expected)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccCallerAccessGrantsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3control_caller_access_grants.test"
+	readDataSourceName := "data.aws_s3control_caller_access_grants.read"
+	writeDataSourceName := "data.aws_s3control_caller_access_grants.write"
+	otherDataSourceName := "data.aws_s3control_caller_access_grants.other"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCallerAccessGrantsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants_instance_arn", "aws_s3control_access_grants_instance.test", "access_grants_instance_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants_instance_id", "aws_s3control_access_grants_instance.test", "access_grants_instance_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_grants.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants.0.access_grant_arn", "aws_s3control_access_grant.test", "access_grant_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_grants.0.permission", "READ"),
+					resource.TestCheckResourceAttr(dataSourceName, "grantee.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "grantee.0.grantee_identifier", "data.aws_iam_session_context.current", "issuer_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "grantee.0.grantee_type", "IAM"),
+					resource.TestCheckResourceAttr(readDataSourceName, "access_grants.#", "1"),
+					resource.TestCheckResourceAttr(writeDataSourceName, "access_grants.#", "0"),
+					resource.TestCheckResourceAttr(otherDataSourceName, "access_grants.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCallerAccessGrantsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessGrantsLocationConfig_basic(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_s3control_access_grant" "test" {
+  access_grants_location_id = aws_s3control_access_grants_location.test.access_grants_location_id
+  permission                = "READ"
+
+  access_grants_location_configuration {
+    s3_sub_prefix = "%[1]s/data/*"
+  }
+
+  grantee {
+    grantee_type       = "IAM"
+    grantee_identifier = data.aws_iam_session_context.current.issuer_arn
+  }
+}
+
+data "aws_s3control_caller_access_grants" "test" {
+  s3_prefix = "s3://%[1]s/data/reports/"
+
+  depends_on = [aws_s3control_access_grant.test]
+}
+
+data "aws_s3control_caller_access_grants" "read" {
+  s3_prefix  = "s3://%[1]s/data/reports/"
+  permission = "READ"
+
+  depends_on = [aws_s3control_access_grant.test]
+}
+
+data "aws_s3control_caller_access_grants" "write" {
+  s3_prefix  = "s3://%[1]s/data/reports/"
+  permission = "WRITE"
+
+  depends_on = [aws_s3control_access_grant.test]
+}
+
+data "aws_s3control_caller_access_grants" "other" {
+  s3_prefix = "s3://%[1]s/other/"
+
+  depends_on = [aws_s3control_access_grant.test]
+}
+`, rName))
+}
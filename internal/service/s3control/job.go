@@ -0,0 +1,1724 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3control_job", name="Job")
+// @Tags
+func resourceJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobCreate,
+		ReadWithoutTimeout:   resourceJobRead,
+		UpdateWithoutTimeout: resourceJobUpdate,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"completion_report_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"confirmation_required": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"wait_for_completion"},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"manifest", "manifest_generator"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"etag": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"object_version_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spec": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fields": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(s3control.JobManifestFieldName_Values(), false),
+										},
+									},
+									"format": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.JobManifestFormat_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"manifest_generator": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_manifest_output": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"expected_bucket_owner": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"created_after": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidUTCTimestamp,
+									},
+									"created_before": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidUTCTimestamp,
+									},
+									"eligible_for_replication": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"key_name_constraint": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"match_any_prefix": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"match_any_substring": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"match_any_suffix": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"match_any_storage_class": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(s3control.S3StorageClass_Values(), false),
+										},
+									},
+									"object_replication_statuses": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(s3control.ReplicationStatus_Values(), false),
+										},
+									},
+									"object_size_greater_than_bytes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"object_size_less_than_bytes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+						"manifest_output_location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"expected_manifest_bucket_owner": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"manifest_encryption": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												// SSE-S3 encryption is used if no KMS key is specified.
+												"kms_key_id": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"manifest_format": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      s3control.GeneratedManifestFormatS3inventoryReportCsv20211130,
+										ValidateFunc: validation.StringInSlice(s3control.GeneratedManifestFormat_Values(), false),
+									},
+									"manifest_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"source_bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"operation": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_invoke": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"function_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"invocation_schema_version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      "1.0",
+										ValidateFunc: validation.StringInSlice([]string{"1.0", "2.0"}, false),
+									},
+									"user_arguments": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+							ExactlyOneOf: jobOperationKeys,
+						},
+						"s3_delete_object_tagging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{},
+							},
+							ExactlyOneOf: jobOperationKeys,
+						},
+						"s3_initiate_restore_object": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration_in_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"glacier_job_tier": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      s3control.S3GlacierJobTierStandard,
+										ValidateFunc: validation.StringInSlice(s3control.S3GlacierJobTier_Values(), false),
+									},
+								},
+							},
+							ExactlyOneOf: jobOperationKeys,
+						},
+						"s3_put_object_copy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_key_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"canned_access_control_list": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3CannedAccessControlList_Values(), false),
+									},
+									"checksum_algorithm": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3ChecksumAlgorithm_Values(), false),
+									},
+									"metadata_directive": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3MetadataDirective_Values(), false),
+									},
+									"new_object_metadata": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cache_control": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_disposition": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_encoding": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_language": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"requester_charged": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													ForceNew: true,
+												},
+												"sse_algorithm": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(s3control.S3SSEAlgorithm_Values(), false),
+												},
+												"user_metadata": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"new_object_tagging": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"object_lock_legal_hold_status": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3ObjectLockLegalHoldStatus_Values(), false),
+									},
+									"object_lock_mode": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3ObjectLockMode_Values(), false),
+									},
+									"object_lock_retain_until_date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidUTCTimestamp,
+									},
+									"requester_pays": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"sse_aws_kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3StorageClass_Values(), false),
+									},
+									"target_key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"target_resource": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+							ExactlyOneOf: jobOperationKeys,
+						},
+						"s3_put_object_legal_hold": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3ObjectLockLegalHoldStatus_Values(), false),
+									},
+								},
+							},
+							ExactlyOneOf: jobOperationKeys,
+						},
+						"s3_put_object_retention": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bypass_governance_retention": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"mode": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3ObjectLockRetentionMode_Values(), false),
+									},
+									"retain_until_date": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidUTCTimestamp,
+									},
+								},
+							},
+							ExactlyOneOf: jobOperationKeys,
+						},
+						"s3_put_object_tagging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag_set": {
+										Type:     schema.TypeMap,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+							ExactlyOneOf: jobOperationKeys,
+						},
+						"s3_replicate_object": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{},
+							},
+							ExactlyOneOf: jobOperationKeys,
+						},
+					},
+				},
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+			"progress_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number_of_tasks_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"number_of_tasks_succeeded": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_number_of_tasks": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"report": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      s3control.JobReportFormatReportCsv20180820,
+							ValidateFunc: validation.StringInSlice(s3control.JobReportFormat_Values(), false),
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"report_scope": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      s3control.JobReportScopeAllTasks,
+							ValidateFunc: validation.StringInSlice(s3control.JobReportScope_Values(), false),
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+var jobOperationKeys = []string{
+	"operation.0.lambda_invoke",
+	"operation.0.s3_delete_object_tagging",
+	"operation.0.s3_initiate_restore_object",
+	"operation.0.s3_put_object_copy",
+	"operation.0.s3_put_object_legal_hold",
+	"operation.0.s3_put_object_retention",
+	"operation.0.s3_put_object_tagging",
+	"operation.0.s3_replicate_object",
+}
+
+func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(accountID),
+		ClientRequestToken:   aws.String(id.UniqueId()),
+		ConfirmationRequired: aws.Bool(d.Get("confirmation_required").(bool)),
+		Priority:             aws.Int64(int64(d.Get("priority").(int))),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("manifest"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Manifest = expandJobManifest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("manifest_generator"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ManifestGenerator = &s3control.JobManifestGenerator{
+			S3JobManifestGenerator: expandS3JobManifestGenerator(v.([]interface{})[0].(map[string]interface{})),
+		}
+	}
+
+	if v, ok := d.GetOk("operation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Operation = expandJobOperation(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("report"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Report = expandJobReport(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	// The IAM role may not yet be assumable by S3 Batch Operations.
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateJobWithContext(ctx, input)
+	}, s3control.ErrCodeBadRequestException, "Unable to assume role")
+
+	if err != nil {
+		return diag.Errorf("creating S3 Batch Operations Job: %s", err)
+	}
+
+	jobID := aws.StringValue(outputRaw.(*s3control.CreateJobOutput).JobId)
+	d.SetId(JobCreateResourceID(accountID, jobID))
+
+	if d.Get("wait_for_completion").(bool) {
+		if output, err := waitJobComplete(ctx, conn, accountID, jobID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			if location := jobCompletionReportLocation(output); location != "" {
+				return diag.Errorf("waiting for S3 Batch Operations Job (%s) complete: %s. Completion report: %s", d.Id(), err, location)
+			}
+
+			return diag.Errorf("waiting for S3 Batch Operations Job (%s) complete: %s", d.Id(), err)
+		}
+	}
+
+	return resourceJobRead(ctx, d, meta)
+}
+
+func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn(ctx)
+
+	accountID, jobID, err := JobParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Batch Operations Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", output.JobArn)
+	d.Set("completion_report_location", jobCompletionReportLocation(output))
+	d.Set("confirmation_required", output.ConfirmationRequired)
+	d.Set("description", output.Description)
+	d.Set("job_id", output.JobId)
+	if output.Manifest != nil {
+		if err := d.Set("manifest", []interface{}{flattenJobManifest(output.Manifest)}); err != nil {
+			return diag.Errorf("setting manifest: %s", err)
+		}
+	} else {
+		d.Set("manifest", nil)
+	}
+	if output.ManifestGenerator != nil && output.ManifestGenerator.S3JobManifestGenerator != nil {
+		if err := d.Set("manifest_generator", []interface{}{flattenS3JobManifestGenerator(output.ManifestGenerator.S3JobManifestGenerator)}); err != nil {
+			return diag.Errorf("setting manifest_generator: %s", err)
+		}
+	} else {
+		d.Set("manifest_generator", nil)
+	}
+	if output.Operation != nil {
+		if err := d.Set("operation", []interface{}{flattenJobOperation(output.Operation)}); err != nil {
+			return diag.Errorf("setting operation: %s", err)
+		}
+	} else {
+		d.Set("operation", nil)
+	}
+	d.Set("priority", output.Priority)
+	if output.ProgressSummary != nil {
+		if err := d.Set("progress_summary", []interface{}{flattenJobProgressSummary(output.ProgressSummary)}); err != nil {
+			return diag.Errorf("setting progress_summary: %s", err)
+		}
+	} else {
+		d.Set("progress_summary", nil)
+	}
+	if output.Report != nil {
+		if err := d.Set("report", []interface{}{flattenJobReport(output.Report)}); err != nil {
+			return diag.Errorf("setting report: %s", err)
+		}
+	} else {
+		d.Set("report", nil)
+	}
+	d.Set("role_arn", output.RoleArn)
+	d.Set("status", output.Status)
+
+	tags, err := jobListTags(ctx, conn, accountID, jobID)
+
+	if err != nil {
+		return diag.Errorf("listing tags for S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	setTagsOut(ctx, Tags(tags))
+
+	return nil
+}
+
+func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn(ctx)
+
+	accountID, jobID, err := JobParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("priority") {
+		input := &s3control.UpdateJobPriorityInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Priority:  aws.Int64(int64(d.Get("priority").(int))),
+		}
+
+		_, err := conn.UpdateJobPriorityWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating S3 Batch Operations Job (%s) priority: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := jobUpdateTags(ctx, conn, accountID, jobID, o, n); err != nil {
+			return diag.Errorf("updating S3 Batch Operations Job (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceJobRead(ctx, d, meta)
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn(ctx)
+
+	accountID, jobID, err := JobParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Jobs can't be deleted. Jobs that haven't finished are cancelled and all jobs expire 90 days after they finish.
+	output, err := FindJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	switch aws.StringValue(output.Status) {
+	case s3control.JobStatusCancelled, s3control.JobStatusComplete, s3control.JobStatusFailed:
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling S3 Batch Operations Job: %s", d.Id())
+	_, err = conn.UpdateJobStatusWithContext(ctx, &s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(jobID),
+		RequestedJobStatus: aws.String(s3control.RequestedJobStatusCancelled),
+		StatusUpdateReason: aws.String("Deleted by Terraform"),
+	})
+
+	// The job finished in the meantime.
+	if tfawserr.ErrCodeEquals(err, s3control.ErrCodeJobStatusException, s3control.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("cancelling S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitJobCancelled(ctx, conn, accountID, jobID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for S3 Batch Operations Job (%s) cancel: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const jobResourceIDSeparator = ":"
+
+func JobCreateResourceID(accountID, jobID string) string {
+	parts := []string{accountID, jobID}
+	id := strings.Join(parts, jobResourceIDSeparator)
+
+	return id
+}
+
+func JobParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, jobResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]sjob-id", id, jobResourceIDSeparator)
+}
+
+func FindJobByTwoPartKey(ctx context.Context, conn *s3control.S3Control, accountID, jobID string) (*s3control.JobDescriptor, error) {
+	input := &s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.DescribeJobWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, s3control.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Job == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Job, nil
+}
+
+// jobCompletionReportLocation returns the S3 URI under which the job's completion report is written.
+func jobCompletionReportLocation(apiObject *s3control.JobDescriptor) string {
+	if apiObject == nil || apiObject.Report == nil || !aws.BoolValue(apiObject.Report.Enabled) {
+		return ""
+	}
+
+	bucket := aws.StringValue(apiObject.Report.Bucket)
+	if v, err := arn.Parse(bucket); err == nil {
+		bucket = strings.TrimPrefix(v.Resource, "bucket/")
+	}
+
+	if bucket == "" {
+		return ""
+	}
+
+	location := "s3://" + bucket + "/"
+	if v := strings.Trim(aws.StringValue(apiObject.Report.Prefix), "/"); v != "" {
+		location += v + "/"
+	}
+
+	return location + "job-" + aws.StringValue(apiObject.JobId) + "/"
+}
+
+// jobFailureReasons returns a description of why a job failed or was suspended.
+func jobFailureReasons(apiObject *s3control.JobDescriptor) string {
+	var reasons []string
+
+	for _, v := range apiObject.FailureReasons {
+		if v == nil {
+			continue
+		}
+
+		reasons = append(reasons, fmt.Sprintf("%s: %s", aws.StringValue(v.FailureCode), aws.StringValue(v.FailureReason)))
+	}
+
+	if v := aws.StringValue(apiObject.SuspendedCause); v != "" {
+		reasons = append(reasons, v)
+	}
+
+	if v := aws.StringValue(apiObject.StatusUpdateReason); v != "" {
+		reasons = append(reasons, v)
+	}
+
+	if summary := apiObject.ProgressSummary; summary != nil && aws.Int64Value(summary.NumberOfTasksFailed) > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d of %d tasks failed", aws.Int64Value(summary.NumberOfTasksFailed), aws.Int64Value(summary.TotalNumberOfTasks)))
+	}
+
+	return strings.Join(reasons, "; ")
+}
+
+// Custom S3control tagging functions using similar formatting as other service generated code.
+
+// jobListTags lists S3 Batch Operations job tags.
+func jobListTags(ctx context.Context, conn *s3control.S3Control, accountID, jobID string) (tftags.KeyValueTags, error) {
+	input := &s3control.GetJobTaggingInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.GetJobTaggingWithContext(ctx, input)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	return KeyValueTags(ctx, output.Tags), nil
+}
+
+// jobUpdateTags updates S3 Batch Operations job tags.
+func jobUpdateTags(ctx context.Context, conn *s3control.S3Control, accountID, jobID string, oldTagsMap, newTagsMap any) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := jobListTags(ctx, conn, accountID, jobID)
+
+	if err != nil {
+		return fmt.Errorf("listing resource tags (%s): %w", jobID, err)
+	}
+
+	ignoredTags := allTags.Ignore(oldTags).Ignore(newTags)
+
+	if len(newTags)+len(ignoredTags) > 0 {
+		input := &s3control.PutJobTaggingInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Tags:      Tags(newTags.Merge(ignoredTags)),
+		}
+
+		_, err := conn.PutJobTaggingWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("setting resource tags (%s): %s", jobID, err)
+		}
+	} else if len(oldTags) > 0 && len(ignoredTags) == 0 {
+		input := &s3control.DeleteJobTaggingInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+		}
+
+		_, err := conn.DeleteJobTaggingWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("deleting resource tags (%s): %s", jobID, err)
+		}
+	}
+
+	return nil
+}
+
+func expandJobManifest(tfMap map[string]interface{}) *s3control.JobManifest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobManifest{}
+
+	if v, ok := tfMap["location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		location := &s3control.JobManifestLocation{}
+
+		if v, ok := tfMap["etag"].(string); ok && v != "" {
+			location.ETag = aws.String(v)
+		}
+
+		if v, ok := tfMap["object_arn"].(string); ok && v != "" {
+			location.ObjectArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["object_version_id"].(string); ok && v != "" {
+			location.ObjectVersionId = aws.String(v)
+		}
+
+		apiObject.Location = location
+	}
+
+	if v, ok := tfMap["spec"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		spec := &s3control.JobManifestSpec{}
+
+		if v, ok := tfMap["fields"].([]interface{}); ok && len(v) > 0 {
+			spec.Fields = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["format"].(string); ok && v != "" {
+			spec.Format = aws.String(v)
+		}
+
+		apiObject.Spec = spec
+	}
+
+	return apiObject
+}
+
+func expandS3JobManifestGenerator(tfMap map[string]interface{}) *s3control.S3JobManifestGenerator {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3JobManifestGenerator{}
+
+	if v, ok := tfMap["enable_manifest_output"].(bool); ok {
+		apiObject.EnableManifestOutput = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["expected_bucket_owner"].(string); ok && v != "" {
+		apiObject.ExpectedBucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Filter = expandJobManifestGeneratorFilter(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["manifest_output_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManifestOutputLocation = expandS3ManifestOutputLocation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["source_bucket"].(string); ok && v != "" {
+		apiObject.SourceBucket = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobManifestGeneratorFilter(tfMap map[string]interface{}) *s3control.JobManifestGeneratorFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobManifestGeneratorFilter{}
+
+	if v, ok := tfMap["created_after"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.CreatedAfter = aws.Time(v)
+	}
+
+	if v, ok := tfMap["created_before"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.CreatedBefore = aws.Time(v)
+	}
+
+	if v, ok := tfMap["eligible_for_replication"].(bool); ok && v {
+		apiObject.EligibleForReplication = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["key_name_constraint"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		keyNameConstraint := &s3control.KeyNameConstraint{}
+
+		if v, ok := tfMap["match_any_prefix"].([]interface{}); ok && len(v) > 0 {
+			keyNameConstraint.MatchAnyPrefix = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["match_any_substring"].([]interface{}); ok && len(v) > 0 {
+			keyNameConstraint.MatchAnySubstring = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["match_any_suffix"].([]interface{}); ok && len(v) > 0 {
+			keyNameConstraint.MatchAnySuffix = flex.ExpandStringList(v)
+		}
+
+		apiObject.KeyNameConstraint = keyNameConstraint
+	}
+
+	if v, ok := tfMap["match_any_storage_class"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MatchAnyStorageClass = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["object_replication_statuses"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ObjectReplicationStatuses = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["object_size_greater_than_bytes"].(int); ok && v > 0 {
+		apiObject.ObjectSizeGreaterThanBytes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["object_size_less_than_bytes"].(int); ok && v > 0 {
+		apiObject.ObjectSizeLessThanBytes = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandS3ManifestOutputLocation(tfMap map[string]interface{}) *s3control.S3ManifestOutputLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3ManifestOutputLocation{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["expected_manifest_bucket_owner"].(string); ok && v != "" {
+		apiObject.ExpectedManifestBucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["manifest_encryption"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ManifestEncryption = &s3control.GeneratedManifestEncryption{
+			SSES3: &s3control.SSES3Encryption{},
+		}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+				apiObject.ManifestEncryption = &s3control.GeneratedManifestEncryption{
+					SSEKMS: &s3control.SSEKMSEncryption{
+						KeyId: aws.String(v),
+					},
+				}
+			}
+		}
+	}
+
+	if v, ok := tfMap["manifest_format"].(string); ok && v != "" {
+		apiObject.ManifestFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["manifest_prefix"].(string); ok && v != "" {
+		apiObject.ManifestPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobOperation(tfMap map[string]interface{}) *s3control.JobOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobOperation{}
+
+	if v, ok := tfMap["lambda_invoke"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		operation := &s3control.LambdaInvokeOperation{}
+
+		if v, ok := tfMap["function_arn"].(string); ok && v != "" {
+			operation.FunctionArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["invocation_schema_version"].(string); ok && v != "" {
+			operation.InvocationSchemaVersion = aws.String(v)
+		}
+
+		if v, ok := tfMap["user_arguments"].(map[string]interface{}); ok && len(v) > 0 {
+			operation.UserArguments = flex.ExpandStringMap(v)
+		}
+
+		apiObject.LambdaInvoke = operation
+	}
+
+	// Operations without parameters are configured with empty blocks.
+	if v, ok := tfMap["s3_delete_object_tagging"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3DeleteObjectTagging = &s3control.S3DeleteObjectTaggingOperation{}
+	}
+
+	if v, ok := tfMap["s3_initiate_restore_object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		operation := &s3control.S3InitiateRestoreObjectOperation{}
+
+		if v, ok := tfMap["expiration_in_days"].(int); ok && v > 0 {
+			operation.ExpirationInDays = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["glacier_job_tier"].(string); ok && v != "" {
+			operation.GlacierJobTier = aws.String(v)
+		}
+
+		apiObject.S3InitiateRestoreObject = operation
+	}
+
+	if v, ok := tfMap["s3_put_object_copy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectCopy = expandS3CopyObjectOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_legal_hold"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.S3PutObjectLegalHold = &s3control.S3SetObjectLegalHoldOperation{
+			LegalHold: &s3control.S3ObjectLockLegalHold{
+				Status: aws.String(tfMap["status"].(string)),
+			},
+		}
+	}
+
+	if v, ok := tfMap["s3_put_object_retention"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		operation := &s3control.S3SetObjectRetentionOperation{
+			Retention: &s3control.S3Retention{},
+		}
+
+		if v, ok := tfMap["bypass_governance_retention"].(bool); ok && v {
+			operation.BypassGovernanceRetention = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["mode"].(string); ok && v != "" {
+			operation.Retention.Mode = aws.String(v)
+		}
+
+		if v, ok := tfMap["retain_until_date"].(string); ok && v != "" {
+			v, _ := time.Parse(time.RFC3339, v)
+			operation.Retention.RetainUntilDate = aws.Time(v)
+		}
+
+		apiObject.S3PutObjectRetention = operation
+	}
+
+	if v, ok := tfMap["s3_put_object_tagging"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.S3PutObjectTagging = &s3control.S3SetObjectTaggingOperation{
+			TagSet: Tags(tftags.New(context.Background(), tfMap["tag_set"].(map[string]interface{}))),
+		}
+	}
+
+	if v, ok := tfMap["s3_replicate_object"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3ReplicateObject = &s3control.S3ReplicateObjectOperation{}
+	}
+
+	return apiObject
+}
+
+func expandS3CopyObjectOperation(tfMap map[string]interface{}) *s3control.S3CopyObjectOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3CopyObjectOperation{}
+
+	if v, ok := tfMap["bucket_key_enabled"].(bool); ok && v {
+		apiObject.BucketKeyEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["canned_access_control_list"].(string); ok && v != "" {
+		apiObject.CannedAccessControlList = aws.String(v)
+	}
+
+	if v, ok := tfMap["checksum_algorithm"].(string); ok && v != "" {
+		apiObject.ChecksumAlgorithm = aws.String(v)
+	}
+
+	if v, ok := tfMap["metadata_directive"].(string); ok && v != "" {
+		apiObject.MetadataDirective = aws.String(v)
+	}
+
+	if v, ok := tfMap["new_object_metadata"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NewObjectMetadata = expandS3ObjectMetadata(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["new_object_tagging"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.NewObjectTagging = Tags(tftags.New(context.Background(), v))
+	}
+
+	if v, ok := tfMap["object_lock_legal_hold_status"].(string); ok && v != "" {
+		apiObject.ObjectLockLegalHoldStatus = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_lock_mode"].(string); ok && v != "" {
+		apiObject.ObjectLockMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_lock_retain_until_date"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.ObjectLockRetainUntilDate = aws.Time(v)
+	}
+
+	if v, ok := tfMap["requester_pays"].(bool); ok && v {
+		apiObject.RequesterPays = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["sse_aws_kms_key_id"].(string); ok && v != "" {
+		apiObject.SSEAwsKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+		apiObject.StorageClass = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_key_prefix"].(string); ok && v != "" {
+		apiObject.TargetKeyPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_resource"].(string); ok && v != "" {
+		apiObject.TargetResource = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3ObjectMetadata(tfMap map[string]interface{}) *s3control.S3ObjectMetadata {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3ObjectMetadata{}
+
+	if v, ok := tfMap["cache_control"].(string); ok && v != "" {
+		apiObject.CacheControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_disposition"].(string); ok && v != "" {
+		apiObject.ContentDisposition = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_encoding"].(string); ok && v != "" {
+		apiObject.ContentEncoding = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_language"].(string); ok && v != "" {
+		apiObject.ContentLanguage = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_type"].(string); ok && v != "" {
+		apiObject.ContentType = aws.String(v)
+	}
+
+	if v, ok := tfMap["requester_charged"].(bool); ok && v {
+		apiObject.RequesterCharged = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["sse_algorithm"].(string); ok && v != "" {
+		apiObject.SSEAlgorithm = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_metadata"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.UserMetadata = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func expandJobReport(tfMap map[string]interface{}) *s3control.JobReport {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobReport{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	// Only the Enabled field can be specified for disabled reports.
+	if !aws.BoolValue(apiObject.Enabled) {
+		return apiObject
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = aws.String(v)
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["report_scope"].(string); ok && v != "" {
+		apiObject.ReportScope = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenJobManifest(apiObject *s3control.JobManifest) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Location; v != nil {
+		tfMap["location"] = []interface{}{map[string]interface{}{
+			"etag":              aws.StringValue(v.ETag),
+			"object_arn":        aws.StringValue(v.ObjectArn),
+			"object_version_id": aws.StringValue(v.ObjectVersionId),
+		}}
+	}
+
+	if v := apiObject.Spec; v != nil {
+		tfMap["spec"] = []interface{}{map[string]interface{}{
+			"fields": flex.FlattenStringList(v.Fields),
+			"format": aws.StringValue(v.Format),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenS3JobManifestGenerator(apiObject *s3control.S3JobManifestGenerator) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enable_manifest_output": aws.BoolValue(apiObject.EnableManifestOutput),
+		"expected_bucket_owner":  aws.StringValue(apiObject.ExpectedBucketOwner),
+		"source_bucket":          aws.StringValue(apiObject.SourceBucket),
+	}
+
+	if v := apiObject.Filter; v != nil {
+		tfMap["filter"] = []interface{}{flattenJobManifestGeneratorFilter(v)}
+	}
+
+	if v := apiObject.ManifestOutputLocation; v != nil {
+		tfMap["manifest_output_location"] = []interface{}{flattenS3ManifestOutputLocation(v)}
+	}
+
+	return tfMap
+}
+
+func flattenJobManifestGeneratorFilter(apiObject *s3control.JobManifestGeneratorFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"eligible_for_replication":       aws.BoolValue(apiObject.EligibleForReplication),
+		"match_any_storage_class":        flex.FlattenStringList(apiObject.MatchAnyStorageClass),
+		"object_replication_statuses":    flex.FlattenStringList(apiObject.ObjectReplicationStatuses),
+		"object_size_greater_than_bytes": aws.Int64Value(apiObject.ObjectSizeGreaterThanBytes),
+		"object_size_less_than_bytes":    aws.Int64Value(apiObject.ObjectSizeLessThanBytes),
+	}
+
+	if v := apiObject.CreatedAfter; v != nil {
+		tfMap["created_after"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.CreatedBefore; v != nil {
+		tfMap["created_before"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.KeyNameConstraint; v != nil {
+		tfMap["key_name_constraint"] = []interface{}{map[string]interface{}{
+			"match_any_prefix":    flex.FlattenStringList(v.MatchAnyPrefix),
+			"match_any_substring": flex.FlattenStringList(v.MatchAnySubstring),
+			"match_any_suffix":    flex.FlattenStringList(v.MatchAnySuffix),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenS3ManifestOutputLocation(apiObject *s3control.S3ManifestOutputLocation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket":                         aws.StringValue(apiObject.Bucket),
+		"expected_manifest_bucket_owner": aws.StringValue(apiObject.ExpectedManifestBucketOwner),
+		"manifest_format":                aws.StringValue(apiObject.ManifestFormat),
+		"manifest_prefix":                aws.StringValue(apiObject.ManifestPrefix),
+	}
+
+	if v := apiObject.ManifestEncryption; v != nil {
+		var kmsKeyID string
+
+		if v.SSEKMS != nil {
+			kmsKeyID = aws.StringValue(v.SSEKMS.KeyId)
+		}
+
+		tfMap["manifest_encryption"] = []interface{}{map[string]interface{}{
+			"kms_key_id": kmsKeyID,
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenJobOperation(apiObject *s3control.JobOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LambdaInvoke; v != nil {
+		tfMap["lambda_invoke"] = []interface{}{map[string]interface{}{
+			"function_arn":              aws.StringValue(v.FunctionArn),
+			"invocation_schema_version": aws.StringValue(v.InvocationSchemaVersion),
+			"user_arguments":            aws.StringValueMap(v.UserArguments),
+		}}
+	}
+
+	if v := apiObject.S3DeleteObjectTagging; v != nil {
+		tfMap["s3_delete_object_tagging"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if v := apiObject.S3InitiateRestoreObject; v != nil {
+		tfMap["s3_initiate_restore_object"] = []interface{}{map[string]interface{}{
+			"expiration_in_days": aws.Int64Value(v.ExpirationInDays),
+			"glacier_job_tier":   aws.StringValue(v.GlacierJobTier),
+		}}
+	}
+
+	if v := apiObject.S3PutObjectCopy; v != nil {
+		tfMap["s3_put_object_copy"] = []interface{}{flattenS3CopyObjectOperation(v)}
+	}
+
+	if v := apiObject.S3PutObjectLegalHold; v != nil && v.LegalHold != nil {
+		tfMap["s3_put_object_legal_hold"] = []interface{}{map[string]interface{}{
+			"status": aws.StringValue(v.LegalHold.Status),
+		}}
+	}
+
+	if v := apiObject.S3PutObjectRetention; v != nil {
+		m := map[string]interface{}{
+			"bypass_governance_retention": aws.BoolValue(v.BypassGovernanceRetention),
+		}
+
+		if v := v.Retention; v != nil {
+			m["mode"] = aws.StringValue(v.Mode)
+
+			if v := v.RetainUntilDate; v != nil {
+				m["retain_until_date"] = aws.TimeValue(v).Format(time.RFC3339)
+			}
+		}
+
+		tfMap["s3_put_object_retention"] = []interface{}{m}
+	}
+
+	if v := apiObject.S3PutObjectTagging; v != nil {
+		tfMap["s3_put_object_tagging"] = []interface{}{map[string]interface{}{
+			"tag_set": KeyValueTags(context.Background(), v.TagSet).Map(),
+		}}
+	}
+
+	if v := apiObject.S3ReplicateObject; v != nil {
+		tfMap["s3_replicate_object"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return tfMap
+}
+
+func flattenS3CopyObjectOperation(apiObject *s3control.S3CopyObjectOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_key_enabled":            aws.BoolValue(apiObject.BucketKeyEnabled),
+		"canned_access_control_list":    aws.StringValue(apiObject.CannedAccessControlList),
+		"checksum_algorithm":            aws.StringValue(apiObject.ChecksumAlgorithm),
+		"metadata_directive":            aws.StringValue(apiObject.MetadataDirective),
+		"object_lock_legal_hold_status": aws.StringValue(apiObject.ObjectLockLegalHoldStatus),
+		"object_lock_mode":              aws.StringValue(apiObject.ObjectLockMode),
+		"requester_pays":                aws.BoolValue(apiObject.RequesterPays),
+		"sse_aws_kms_key_id":            aws.StringValue(apiObject.SSEAwsKmsKeyId),
+		"storage_class":                 aws.StringValue(apiObject.StorageClass),
+		"target_key_prefix":             aws.StringValue(apiObject.TargetKeyPrefix),
+		"target_resource":               aws.StringValue(apiObject.TargetResource),
+	}
+
+	if v := apiObject.NewObjectMetadata; v != nil {
+		tfMap["new_object_metadata"] = []interface{}{map[string]interface{}{
+			"cache_control":       aws.StringValue(v.CacheControl),
+			"content_disposition": aws.StringValue(v.ContentDisposition),
+			"content_encoding":    aws.StringValue(v.ContentEncoding),
+			"content_language":    aws.StringValue(v.ContentLanguage),
+			"content_type":        aws.StringValue(v.ContentType),
+			"requester_charged":   aws.BoolValue(v.RequesterCharged),
+			"sse_algorithm":       aws.StringValue(v.SSEAlgorithm),
+			"user_metadata":       aws.StringValueMap(v.UserMetadata),
+		}}
+	}
+
+	if v := apiObject.NewObjectTagging; v != nil {
+		tfMap["new_object_tagging"] = KeyValueTags(context.Background(), v).Map()
+	}
+
+	if v := apiObject.ObjectLockRetainUntilDate; v != nil {
+		tfMap["object_lock_retain_until_date"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenJobProgressSummary(apiObject *s3control.JobProgressSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"number_of_tasks_failed":    aws.Int64Value(apiObject.NumberOfTasksFailed),
+		"number_of_tasks_succeeded": aws.Int64Value(apiObject.NumberOfTasksSucceeded),
+		"total_number_of_tasks":     aws.Int64Value(apiObject.TotalNumberOfTasks),
+	}
+
+	return tfMap
+}
+
+func flattenJobReport(apiObject *s3control.JobReport) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket":       aws.StringValue(apiObject.Bucket),
+		"enabled":      aws.BoolValue(apiObject.Enabled),
+		"format":       aws.StringValue(apiObject.Format),
+		"prefix":       aws.StringValue(apiObject.Prefix),
+		"report_scope": aws.StringValue(apiObject.ReportScope),
+	}
+
+	return tfMap
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+)
+
+func TestJobCompletionReportLocation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Job      *s3control.JobDescriptor
+		Expected string
+	}{
+		{
+			Name: "no report",
+			Job:  &s3control.JobDescriptor{JobId: aws.String("id")},
+		},
+		{
+			Name: "disabled",
+			Job: &s3control.JobDescriptor{
+				JobId:  aws.String("id"),
+				Report: &s3control.JobReport{Enabled: aws.Bool(false)},
+			},
+		},
+		{
+			Name: "no prefix",
+			Job: &s3control.JobDescriptor{
+				JobId: aws.String("id"),
+				Report: &s3control.JobReport{
+					Bucket:  aws.String("arn:aws:s3:::reports"), // lintignore:AWSAT005
+					Enabled: aws.Bool(true),
+				},
+			},
+			Expected: "s3://reports/job-id/",
+		},
+		{
+			Name: "prefix",
+			Job: &s3control.JobDescriptor{
+				JobId: aws.String("id"),
+				Report: &s3control.JobReport{
+					Bucket:  aws.String("arn:aws:s3:::reports"), // lintignore:AWSAT005
+					Enabled: aws.Bool(true),
+					Prefix:  aws.String("batch/"),
+				},
+			},
+			Expected: "s3://reports/batch/job-id/",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := jobCompletionReportLocation(testCase.Job); got != testCase.Expected {
+				t.Errorf("jobCompletionReportLocation() = %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -31,6 +31,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceAccountPublicAccessBlock,
 			TypeName: "aws_s3_account_public_access_block",
 		},
+		{
+			Factory:  dataSourceCallerAccessGrants,
+			TypeName: "aws_s3control_caller_access_grants",
+			Name:     "Caller Access Grants",
+		},
 		{
 			Factory:  dataSourceMultiRegionAccessPoint,
 			TypeName: "aws_s3control_multi_region_access_point",
@@ -48,6 +53,29 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAccountPublicAccessBlock,
 			TypeName: "aws_s3_account_public_access_block",
 		},
+		{
+			Factory:  resourceAccessGrant,
+			TypeName: "aws_s3control_access_grant",
+			Name:     "Access Grant",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
+		{
+			Factory:  resourceAccessGrantsInstance,
+			TypeName: "aws_s3control_access_grants_instance",
+			Name:     "Access Grants Instance",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
+		{
+			Factory:  resourceAccessGrantsInstanceResourcePolicy,
+			TypeName: "aws_s3control_access_grants_instance_resource_policy",
+			Name:     "Access Grants Instance Resource Policy",
+		},
+		{
+			Factory:  resourceAccessGrantsLocation,
+			TypeName: "aws_s3control_access_grants_location",
+			Name:     "Access Grants Location",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  resourceAccessPointPolicy,
 			TypeName: "aws_s3control_access_point_policy",
@@ -66,6 +94,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceBucketPolicy,
 			TypeName: "aws_s3control_bucket_policy",
 		},
+		{
+			Factory:  resourceJob,
+			TypeName: "aws_s3control_job",
+			Name:     "Job",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  resourceMultiRegionAccessPoint,
 			TypeName: "aws_s3control_multi_region_access_point",
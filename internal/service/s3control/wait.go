@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitJobComplete(ctx context.Context, conn *s3control.S3Control, accountID, jobID string, timeout time.Duration) (*s3control.JobDescriptor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			s3control.JobStatusActive,
+			s3control.JobStatusCompleting,
+			s3control.JobStatusNew,
+			s3control.JobStatusPaused,
+			s3control.JobStatusPausing,
+			s3control.JobStatusPreparing,
+			s3control.JobStatusReady,
+		},
+		Target:     []string{s3control.JobStatusComplete},
+		Refresh:    statusJob(ctx, conn, accountID, jobID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		if reasons := jobFailureReasons(output); reasons != "" {
+			switch aws.StringValue(output.Status) {
+			case s3control.JobStatusCancelled, s3control.JobStatusCancelling, s3control.JobStatusFailed, s3control.JobStatusFailing, s3control.JobStatusSuspended:
+				tfresource.SetLastError(err, errors.New(reasons))
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobCancelled(ctx context.Context, conn *s3control.S3Control, accountID, jobID string, timeout time.Duration) (*s3control.JobDescriptor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			s3control.JobStatusActive,
+			s3control.JobStatusCancelling,
+			s3control.JobStatusNew,
+			s3control.JobStatusPaused,
+			s3control.JobStatusPausing,
+			s3control.JobStatusPreparing,
+			s3control.JobStatusReady,
+			s3control.JobStatusSuspended,
+		},
+		Target: []string{
+			s3control.JobStatusCancelled,
+			s3control.JobStatusComplete,
+			s3control.JobStatusFailed,
+		},
+		Refresh:    statusJob(ctx, conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
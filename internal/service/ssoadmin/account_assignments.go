@@ -0,0 +1,463 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ssoadmin_account_assignments")
+func ResourceAccountAssignments() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccountAssignmentsCreate,
+		ReadWithoutTimeout:   resourceAccountAssignmentsRead,
+		UpdateWithoutTimeout: resourceAccountAssignmentsUpdate,
+		DeleteWithoutTimeout: resourceAccountAssignmentsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceAccountAssignmentsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"assignments": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"permission_set_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"permission_set_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"principal": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 47),
+								validation.StringMatch(regexp.MustCompile(`^([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}$`), "must match ([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}"),
+							),
+						},
+						"principal_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(ssoadmin.PrincipalType_Values(), false),
+						},
+					},
+				},
+			},
+			"target_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+		},
+	}
+}
+
+func resourceAccountAssignmentsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn(ctx)
+
+	instanceARN := d.Get("instance_arn").(string)
+	maxConcurrency := d.Get("max_concurrency").(int)
+	desired := expandAccountAssignmentsMatrix(d.Get("permission_set_arns").(*schema.Set), d.Get("principal").(*schema.Set), d.Get("target_ids").(*schema.Set))
+
+	// The API doesn't prevent duplicate assignments, so only create the ones that are missing.
+	actual, err := findAccountAssignmentsByMatrix(ctx, conn, instanceARN, desired, maxConcurrency)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing SSO Account Assignments: %s", err)
+	}
+
+	if err := applyAccountAssignmentsChanges(ctx, conn, instanceARN, desired.difference(actual), nil, maxConcurrency, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSO Account Assignments: %s", err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	return append(diags, resourceAccountAssignmentsRead(ctx, d, meta)...)
+}
+
+func resourceAccountAssignmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn(ctx)
+
+	instanceARN := d.Get("instance_arn").(string)
+	desired := expandAccountAssignmentsMatrix(d.Get("permission_set_arns").(*schema.Set), d.Get("principal").(*schema.Set), d.Get("target_ids").(*schema.Set))
+
+	actual, err := findAccountAssignmentsByMatrix(ctx, conn, instanceARN, desired, d.Get("max_concurrency").(int))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Account Assignments (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("assignments", flattenAccountAssignmentsMatrix(actual)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting assignments: %s", err)
+	}
+
+	return diags
+}
+
+func resourceAccountAssignmentsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn(ctx)
+
+	instanceARN := d.Get("instance_arn").(string)
+	maxConcurrency := d.Get("max_concurrency").(int)
+
+	oPermissionSetARNs, nPermissionSetARNs := d.GetChange("permission_set_arns")
+	oPrincipals, nPrincipals := d.GetChange("principal")
+	oTargetIDs, nTargetIDs := d.GetChange("target_ids")
+	previous := expandAccountAssignmentsMatrix(oPermissionSetARNs.(*schema.Set), oPrincipals.(*schema.Set), oTargetIDs.(*schema.Set))
+	desired := expandAccountAssignmentsMatrix(nPermissionSetARNs.(*schema.Set), nPrincipals.(*schema.Set), nTargetIDs.(*schema.Set))
+
+	actual, err := findAccountAssignmentsByMatrix(ctx, conn, instanceARN, previous.union(desired), maxConcurrency)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing SSO Account Assignments (%s): %s", d.Id(), err)
+	}
+
+	// Only assignments that this resource previously managed are removed.
+	add := desired.difference(actual)
+	del := previous.difference(desired).intersection(actual)
+
+	if err := applyAccountAssignmentsChanges(ctx, conn, instanceARN, add, del, maxConcurrency, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SSO Account Assignments (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAccountAssignmentsRead(ctx, d, meta)...)
+}
+
+func resourceAccountAssignmentsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn(ctx)
+
+	instanceARN := d.Get("instance_arn").(string)
+	maxConcurrency := d.Get("max_concurrency").(int)
+	previous := expandAccountAssignmentsMatrix(d.Get("permission_set_arns").(*schema.Set), d.Get("principal").(*schema.Set), d.Get("target_ids").(*schema.Set))
+
+	actual, err := findAccountAssignmentsByMatrix(ctx, conn, instanceARN, previous, maxConcurrency)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing SSO Account Assignments (%s): %s", d.Id(), err)
+	}
+
+	if err := applyAccountAssignmentsChanges(ctx, conn, instanceARN, nil, actual, maxConcurrency, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSO Account Assignments (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceAccountAssignmentsCustomizeDiff plans the full matrix into the computed
+// assignments attribute so that both configuration changes and assignments
+// removed outside of Terraform show up as a diff.
+func resourceAccountAssignmentsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("permission_set_arns") || !d.NewValueKnown("principal") || !d.NewValueKnown("target_ids") {
+		return d.SetNewComputed("assignments")
+	}
+
+	desired := expandAccountAssignmentsMatrix(d.Get("permission_set_arns").(*schema.Set), d.Get("principal").(*schema.Set), d.Get("target_ids").(*schema.Set))
+	current := expandAccountAssignmentsSet(d.Get("assignments").(*schema.Set))
+
+	if desired.equal(current) {
+		return nil
+	}
+
+	return d.SetNew("assignments", flattenAccountAssignmentsMatrix(desired))
+}
+
+type accountAssignmentKey struct {
+	PermissionSetARN string
+	PrincipalID      string
+	PrincipalType    string
+	TargetID         string
+}
+
+func (k accountAssignmentKey) String() string {
+	return fmt.Sprintf("%s (%s) -> %s in %s", k.PrincipalType, k.PrincipalID, k.PermissionSetARN, k.TargetID)
+}
+
+type accountAssignmentsMatrix map[accountAssignmentKey]struct{}
+
+func (m accountAssignmentsMatrix) difference(other accountAssignmentsMatrix) accountAssignmentsMatrix {
+	result := make(accountAssignmentsMatrix)
+	for k := range m {
+		if _, ok := other[k]; !ok {
+			result[k] = struct{}{}
+		}
+	}
+	return result
+}
+
+func (m accountAssignmentsMatrix) intersection(other accountAssignmentsMatrix) accountAssignmentsMatrix {
+	result := make(accountAssignmentsMatrix)
+	for k := range m {
+		if _, ok := other[k]; ok {
+			result[k] = struct{}{}
+		}
+	}
+	return result
+}
+
+func (m accountAssignmentsMatrix) union(other accountAssignmentsMatrix) accountAssignmentsMatrix {
+	result := make(accountAssignmentsMatrix, len(m)+len(other))
+	for k := range m {
+		result[k] = struct{}{}
+	}
+	for k := range other {
+		result[k] = struct{}{}
+	}
+	return result
+}
+
+func (m accountAssignmentsMatrix) equal(other accountAssignmentsMatrix) bool {
+	return len(m) == len(other) && len(m.difference(other)) == 0
+}
+
+func expandAccountAssignmentsMatrix(permissionSetARNs, principals, targetIDs *schema.Set) accountAssignmentsMatrix {
+	matrix := make(accountAssignmentsMatrix)
+
+	for _, permissionSetARN := range permissionSetARNs.List() {
+		for _, v := range principals.List() {
+			principal, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			for _, targetID := range targetIDs.List() {
+				matrix[accountAssignmentKey{
+					PermissionSetARN: permissionSetARN.(string),
+					PrincipalID:      principal["principal_id"].(string),
+					PrincipalType:    principal["principal_type"].(string),
+					TargetID:         targetID.(string),
+				}] = struct{}{}
+			}
+		}
+	}
+
+	return matrix
+}
+
+func expandAccountAssignmentsSet(s *schema.Set) accountAssignmentsMatrix {
+	matrix := make(accountAssignmentsMatrix)
+
+	for _, v := range s.List() {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		matrix[accountAssignmentKey{
+			PermissionSetARN: tfMap["permission_set_arn"].(string),
+			PrincipalID:      tfMap["principal_id"].(string),
+			PrincipalType:    tfMap["principal_type"].(string),
+			TargetID:         tfMap["target_id"].(string),
+		}] = struct{}{}
+	}
+
+	return matrix
+}
+
+func flattenAccountAssignmentsMatrix(matrix accountAssignmentsMatrix) []interface{} {
+	tfList := make([]interface{}, 0, len(matrix))
+
+	for k := range matrix {
+		tfList = append(tfList, map[string]interface{}{
+			"permission_set_arn": k.PermissionSetARN,
+			"principal_id":       k.PrincipalID,
+			"principal_type":     k.PrincipalType,
+			"target_id":          k.TargetID,
+		})
+	}
+
+	return tfList
+}
+
+// applyAccountAssignmentsChanges submits all creations and deletions with at most
+// maxConcurrency requests in flight and then waits for every provisioning request together.
+func applyAccountAssignmentsChanges(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceARN string, add, del accountAssignmentsMatrix, maxConcurrency int, timeout time.Duration) error {
+	if len(add) == 0 && len(del) == 0 {
+		return nil
+	}
+
+	deadline := tfresource.NewDeadline(timeout)
+	requests := &accountAssignmentRequests{
+		creations: make(map[string]accountAssignmentKey),
+		deletions: make(map[string]accountAssignmentKey),
+	}
+
+	var (
+		errs *multierror.Error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	sem := make(chan struct{}, maxConcurrency)
+	submit := func(k accountAssignmentKey, f func(accountAssignmentKey) (string, error), requestIDs map[string]accountAssignmentKey) {
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			requestID, err := f(k)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = multierror.Append(errs, err)
+				return
+			}
+
+			if requestID != "" {
+				requestIDs[requestID] = k
+			}
+		}()
+	}
+
+	createAccountAssignment := func(k accountAssignmentKey) (string, error) {
+		input := &ssoadmin.CreateAccountAssignmentInput{
+			InstanceArn:      aws.String(instanceARN),
+			PermissionSetArn: aws.String(k.PermissionSetARN),
+			PrincipalId:      aws.String(k.PrincipalID),
+			PrincipalType:    aws.String(k.PrincipalType),
+			TargetId:         aws.String(k.TargetID),
+			TargetType:       aws.String(ssoadmin.TargetTypeAwsAccount),
+		}
+
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, deadline.Remaining(), func() (interface{}, error) {
+			return conn.CreateAccountAssignmentWithContext(ctx, input)
+		}, ssoadmin.ErrCodeThrottlingException, ssoadmin.ErrCodeConflictException)
+
+		if err != nil {
+			return "", fmt.Errorf("creating SSO Account Assignment for %s: %w", k, err)
+		}
+
+		output := outputRaw.(*ssoadmin.CreateAccountAssignmentOutput)
+
+		if output == nil || output.AccountAssignmentCreationStatus == nil {
+			return "", fmt.Errorf("creating SSO Account Assignment for %s: empty output", k)
+		}
+
+		return aws.StringValue(output.AccountAssignmentCreationStatus.RequestId), nil
+	}
+
+	deleteAccountAssignment := func(k accountAssignmentKey) (string, error) {
+		input := &ssoadmin.DeleteAccountAssignmentInput{
+			InstanceArn:      aws.String(instanceARN),
+			PermissionSetArn: aws.String(k.PermissionSetARN),
+			PrincipalId:      aws.String(k.PrincipalID),
+			PrincipalType:    aws.String(k.PrincipalType),
+			TargetId:         aws.String(k.TargetID),
+			TargetType:       aws.String(ssoadmin.TargetTypeAwsAccount),
+		}
+
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, deadline.Remaining(), func() (interface{}, error) {
+			return conn.DeleteAccountAssignmentWithContext(ctx, input)
+		}, ssoadmin.ErrCodeThrottlingException, ssoadmin.ErrCodeConflictException)
+
+		if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+			return "", nil
+		}
+
+		if err != nil {
+			return "", fmt.Errorf("deleting SSO Account Assignment for %s: %w", k, err)
+		}
+
+		output := outputRaw.(*ssoadmin.DeleteAccountAssignmentOutput)
+
+		if output == nil || output.AccountAssignmentDeletionStatus == nil {
+			return "", fmt.Errorf("deleting SSO Account Assignment for %s: empty output", k)
+		}
+
+		return aws.StringValue(output.AccountAssignmentDeletionStatus.RequestId), nil
+	}
+
+	for k := range del {
+		submit(k, deleteAccountAssignment, requests.deletions)
+	}
+	for k := range add {
+		submit(k, createAccountAssignment, requests.creations)
+	}
+
+	wg.Wait()
+
+	// Wait for whatever was accepted even if some submissions failed so that no
+	// request is left in progress when the apply finishes.
+	if err := waitAccountAssignmentsProvisioned(ctx, conn, instanceARN, requests, deadline.Remaining()); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
+	return errs.ErrorOrNil()
+}
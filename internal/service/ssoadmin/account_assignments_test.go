@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+)
+
+func TestAccSSOAdminAccountAssignments_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_account_assignments.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountAssignmentsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsConfig_basic(rName, 1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignments.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "10"),
+					resource.TestCheckResourceAttr(resourceName, "permission_set_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "principal.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_ids.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "assignments.*", map[string]string{
+						"principal_type": ssoadmin.PrincipalTypeGroup,
+					}),
+				),
+			},
+			{
+				Config: testAccAccountAssignmentsConfig_basic(rName, 2, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignments.#", "6"),
+					resource.TestCheckResourceAttr(resourceName, "permission_set_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "principal.#", "3"),
+				),
+			},
+			{
+				Config: testAccAccountAssignmentsConfig_basic(rName, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignments.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminAccountAssignments_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_account_assignments.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountAssignmentsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsConfig_basic(rName, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssoadmin.ResourceAccountAssignments(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccountAssignmentsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssoadmin_account_assignments" {
+				continue
+			}
+
+			for _, a := range testAccAccountAssignmentsFromState(rs) {
+				accountAssignment, err := tfssoadmin.FindAccountAssignment(ctx, conn, a["principal_id"], a["principal_type"], a["target_id"], a["permission_set_arn"], rs.Primary.Attributes["instance_arn"])
+
+				if err != nil {
+					return err
+				}
+
+				if accountAssignment != nil {
+					return fmt.Errorf("SSO Account Assignment for %s (%s) still exists", a["principal_type"], a["principal_id"])
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccountAssignmentsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSO Account Assignments ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn(ctx)
+
+		for _, a := range testAccAccountAssignmentsFromState(rs) {
+			accountAssignment, err := tfssoadmin.FindAccountAssignment(ctx, conn, a["principal_id"], a["principal_type"], a["target_id"], a["permission_set_arn"], rs.Primary.Attributes["instance_arn"])
+
+			if err != nil {
+				return err
+			}
+
+			if accountAssignment == nil {
+				return fmt.Errorf("SSO Account Assignment for %s (%s) not found", a["principal_type"], a["principal_id"])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAccountAssignmentsFromState(rs *terraform.ResourceState) []map[string]string {
+	n, _ := strconv.Atoi(rs.Primary.Attributes["assignments.#"])
+	assignments := make([]map[string]string, 0, n)
+
+	for k, v := range rs.Primary.Attributes {
+		if !strings.HasPrefix(k, "assignments.") || !strings.HasSuffix(k, ".principal_id") {
+			continue
+		}
+
+		prefix := strings.TrimSuffix(k, "principal_id")
+		assignments = append(assignments, map[string]string{
+			"permission_set_arn": rs.Primary.Attributes[prefix+"permission_set_arn"],
+			"principal_id":       v,
+			"principal_type":     rs.Primary.Attributes[prefix+"principal_type"],
+			"target_id":          rs.Primary.Attributes[prefix+"target_id"],
+		})
+	}
+
+	return assignments
+}
+
+func testAccAccountAssignmentsConfig_basic(rName string, permissionSetCount, groupCount int) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  count = %[2]d
+
+  name         = "%[1]s-${count.index}"
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+resource "aws_identitystore_group" "test" {
+  count = %[3]d
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = "%[1]s-${count.index}"
+}
+
+resource "aws_ssoadmin_account_assignments" "test" {
+  instance_arn        = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  permission_set_arns = aws_ssoadmin_permission_set.test[*].arn
+  target_ids          = [data.aws_caller_identity.current.account_id]
+
+  dynamic "principal" {
+    for_each = aws_identitystore_group.test
+
+    content {
+      principal_id   = principal.value.group_id
+      principal_type = "GROUP"
+    }
+  }
+}
+`, rName, permissionSetCount, groupCount)
+}
@@ -0,0 +1,512 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Application")
+// @Tags
+func newResourceApplication(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceApplication{}, nil
+}
+
+const (
+	ResNameApplication = "Application"
+)
+
+type resourceApplication struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceApplication) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_application"
+}
+
+func (r *resourceApplication) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_account": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_arn": framework.ARNAttributeComputedOnly(),
+			"application_provider_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"instance_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"status": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(ssoadmin.ApplicationStatus_Values()...),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"portal_options": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"visibility": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+								stringplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.String{
+								stringvalidator.OneOf(ssoadmin.ApplicationVisibility_Values()...),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"sign_in_options": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"application_url": schema.StringAttribute{
+										Optional: true,
+									},
+									"origin": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(ssoadmin.SignInOrigin_Values()...),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceApplication) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	var plan resourceApplicationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &ssoadmin.CreateApplicationInput{
+		ApplicationProviderArn: aws.String(plan.ApplicationProviderARN.ValueString()),
+		InstanceArn:            aws.String(plan.InstanceARN.ValueString()),
+		Name:                   aws.String(plan.Name.ValueString()),
+		Tags:                   getTagsIn(ctx),
+	}
+
+	if !plan.Description.IsNull() {
+		in.Description = aws.String(plan.Description.ValueString())
+	}
+	if !plan.Status.IsNull() && !plan.Status.IsUnknown() {
+		in.Status = aws.String(plan.Status.ValueString())
+	}
+	if len(plan.PortalOptions.Elements()) > 0 {
+		var tfList []portalOptionsData
+		resp.Diagnostics.Append(plan.PortalOptions.ElementsAs(ctx, &tfList, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		portalOptions, d := expandPortalOptions(ctx, tfList)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		in.PortalOptions = portalOptions
+	}
+
+	out, err := conn.CreateApplicationWithContext(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameApplication, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || out.ApplicationArn == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameApplication, plan.Name.String(), nil),
+			"empty output",
+		)
+		return
+	}
+
+	plan.ApplicationARN = flex.StringToFramework(ctx, out.ApplicationArn)
+	plan.ID = flex.StringToFramework(ctx, out.ApplicationArn)
+
+	application, err := FindApplicationByARN(ctx, conn, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameApplication, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ApplicationAccount = flex.StringToFramework(ctx, application.ApplicationAccount)
+	plan.Status = flex.StringToFramework(ctx, application.Status)
+	if len(plan.PortalOptions.Elements()) > 0 {
+		portalOptions, d := flattenPortalOptions(ctx, application.PortalOptions)
+		resp.Diagnostics.Append(d...)
+		plan.PortalOptions = portalOptions
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceApplication) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	var state resourceApplicationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := FindApplicationByARN(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionSetting, ResNameApplication, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.ApplicationAccount = flex.StringToFramework(ctx, out.ApplicationAccount)
+	state.ApplicationARN = flex.StringToFramework(ctx, out.ApplicationArn)
+	state.ApplicationProviderARN = flex.StringToFramework(ctx, out.ApplicationProviderArn)
+	state.Description = flex.StringToFramework(ctx, out.Description)
+	state.InstanceARN = flex.StringToFramework(ctx, out.InstanceArn)
+	state.Name = flex.StringToFramework(ctx, out.Name)
+	state.Status = flex.StringToFramework(ctx, out.Status)
+
+	// The API returns default portal options for applications configured without them.
+	// Only track them when they are configured or carry sign-in options (e.g. on import).
+	portalOptions := out.PortalOptions
+	if len(state.PortalOptions.Elements()) == 0 && (portalOptions == nil || portalOptions.SignInOptions == nil) {
+		portalOptions = nil
+	}
+	var d diag.Diagnostics
+	state.PortalOptions, d = flattenPortalOptions(ctx, portalOptions)
+	resp.Diagnostics.Append(d...)
+
+	tags, err := listTags(ctx, conn, state.ID.ValueString(), state.InstanceARN.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionSetting, ResNameApplication, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	setTagsOut(ctx, Tags(tags))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceApplication) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	var plan, state resourceApplicationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) ||
+		!plan.Name.Equal(state.Name) ||
+		!plan.PortalOptions.Equal(state.PortalOptions) ||
+		!plan.Status.Equal(state.Status) {
+		in := &ssoadmin.UpdateApplicationInput{
+			ApplicationArn: aws.String(plan.ID.ValueString()),
+			Name:           aws.String(plan.Name.ValueString()),
+		}
+
+		if !plan.Description.IsNull() {
+			in.Description = aws.String(plan.Description.ValueString())
+		}
+		if !plan.Status.IsNull() && !plan.Status.IsUnknown() {
+			in.Status = aws.String(plan.Status.ValueString())
+		}
+		if len(plan.PortalOptions.Elements()) > 0 {
+			var tfList []portalOptionsData
+			resp.Diagnostics.Append(plan.PortalOptions.ElementsAs(ctx, &tfList, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			portalOptions, d := expandPortalOptions(ctx, tfList)
+			resp.Diagnostics.Append(d...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if portalOptions != nil {
+				in.PortalOptions = &ssoadmin.UpdateApplicationPortalOptions{
+					SignInOptions: portalOptions.SignInOptions,
+				}
+			}
+		}
+
+		_, err := conn.UpdateApplicationWithContext(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionUpdating, ResNameApplication, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.TagsAll.Equal(state.TagsAll) {
+		if err := updateTags(ctx, conn, plan.ID.ValueString(), plan.InstanceARN.ValueString(), state.TagsAll, plan.TagsAll); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionUpdating, ResNameApplication, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	application, err := FindApplicationByARN(ctx, conn, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionUpdating, ResNameApplication, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.Status = flex.StringToFramework(ctx, application.Status)
+	if len(plan.PortalOptions.Elements()) > 0 {
+		portalOptions, d := flattenPortalOptions(ctx, application.PortalOptions)
+		resp.Diagnostics.Append(d...)
+		plan.PortalOptions = portalOptions
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceApplication) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	var state resourceApplicationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteApplicationWithContext(ctx, &ssoadmin.DeleteApplicationInput{
+		ApplicationArn: aws.String(state.ID.ValueString()),
+	})
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionDeleting, ResNameApplication, state.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+func (r *resourceApplication) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, req, resp)
+}
+
+func FindApplicationByARN(ctx context.Context, conn *ssoadmin.SSOAdmin, arn string) (*ssoadmin.DescribeApplicationOutput, error) {
+	in := &ssoadmin.DescribeApplicationInput{
+		ApplicationArn: aws.String(arn),
+	}
+
+	out, err := conn.DescribeApplicationWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+var (
+	signInOptionsAttrTypes = map[string]attr.Type{
+		"application_url": types.StringType,
+		"origin":          types.StringType,
+	}
+
+	portalOptionsAttrTypes = map[string]attr.Type{
+		"sign_in_options": types.ListType{ElemType: types.ObjectType{AttrTypes: signInOptionsAttrTypes}},
+		"visibility":      types.StringType,
+	}
+)
+
+type resourceApplicationData struct {
+	ApplicationAccount     types.String `tfsdk:"application_account"`
+	ApplicationARN         types.String `tfsdk:"application_arn"`
+	ApplicationProviderARN types.String `tfsdk:"application_provider_arn"`
+	Description            types.String `tfsdk:"description"`
+	ID                     types.String `tfsdk:"id"`
+	InstanceARN            types.String `tfsdk:"instance_arn"`
+	Name                   types.String `tfsdk:"name"`
+	PortalOptions          types.List   `tfsdk:"portal_options"`
+	Status                 types.String `tfsdk:"status"`
+	Tags                   types.Map    `tfsdk:"tags"`
+	TagsAll                types.Map    `tfsdk:"tags_all"`
+}
+
+type portalOptionsData struct {
+	SignInOptions types.List   `tfsdk:"sign_in_options"`
+	Visibility    types.String `tfsdk:"visibility"`
+}
+
+type signInOptionsData struct {
+	ApplicationURL types.String `tfsdk:"application_url"`
+	Origin         types.String `tfsdk:"origin"`
+}
+
+func expandPortalOptions(ctx context.Context, tfList []portalOptionsData) (*ssoadmin.PortalOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(tfList) == 0 {
+		return nil, diags
+	}
+	tfObj := tfList[0]
+
+	apiObject := &ssoadmin.PortalOptions{}
+	if !tfObj.Visibility.IsNull() && !tfObj.Visibility.IsUnknown() {
+		apiObject.Visibility = aws.String(tfObj.Visibility.ValueString())
+	}
+	if len(tfObj.SignInOptions.Elements()) > 0 {
+		var signInOptions []signInOptionsData
+		diags.Append(tfObj.SignInOptions.ElementsAs(ctx, &signInOptions, false)...)
+
+		if len(signInOptions) > 0 {
+			apiObject.SignInOptions = &ssoadmin.SignInOptions{
+				Origin: aws.String(signInOptions[0].Origin.ValueString()),
+			}
+			if v := signInOptions[0].ApplicationURL; !v.IsNull() {
+				apiObject.SignInOptions.ApplicationUrl = aws.String(v.ValueString())
+			}
+		}
+	}
+
+	return apiObject, diags
+}
+
+func flattenPortalOptions(ctx context.Context, apiObject *ssoadmin.PortalOptions) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: portalOptionsAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{}), diags
+	}
+
+	signInOptions, d := flattenSignInOptions(ctx, apiObject.SignInOptions)
+	diags.Append(d...)
+
+	obj := map[string]attr.Value{
+		"sign_in_options": signInOptions,
+		"visibility":      flex.StringToFramework(ctx, apiObject.Visibility),
+	}
+
+	objVal, d := types.ObjectValue(portalOptionsAttrTypes, obj)
+	diags.Append(d...)
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
+
+func flattenSignInOptions(ctx context.Context, apiObject *ssoadmin.SignInOptions) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: signInOptionsAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{}), diags
+	}
+
+	obj := map[string]attr.Value{
+		"application_url": flex.StringToFramework(ctx, apiObject.ApplicationUrl),
+		"origin":          flex.StringToFramework(ctx, apiObject.Origin),
+	}
+
+	objVal, d := types.ObjectValue(signInOptionsAttrTypes, obj)
+	diags.Append(d...)
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
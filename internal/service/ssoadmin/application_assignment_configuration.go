@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Application Assignment Configuration")
+func newResourceApplicationAssignmentConfiguration(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceApplicationAssignmentConfiguration{}, nil
+}
+
+const (
+	ResNameApplicationAssignmentConfiguration = "Application Assignment Configuration"
+)
+
+type resourceApplicationAssignmentConfiguration struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceApplicationAssignmentConfiguration) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_application_assignment_configuration"
+}
+
+func (r *resourceApplicationAssignmentConfiguration) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"assignment_required": schema.BoolAttribute{
+				Required: true,
+			},
+			"id": framework.IDAttribute(),
+		},
+	}
+}
+
+func (r *resourceApplicationAssignmentConfiguration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	var plan resourceApplicationAssignmentConfigurationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ApplicationARN.ValueString())
+
+	in := &ssoadmin.PutApplicationAssignmentConfigurationInput{
+		ApplicationArn:     aws.String(plan.ApplicationARN.ValueString()),
+		AssignmentRequired: aws.Bool(plan.AssignmentRequired.ValueBool()),
+	}
+
+	_, err := conn.PutApplicationAssignmentConfigurationWithContext(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameApplicationAssignmentConfiguration, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceApplicationAssignmentConfiguration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	var state resourceApplicationAssignmentConfigurationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := FindApplicationAssignmentConfigurationByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionSetting, ResNameApplicationAssignmentConfiguration, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.ApplicationARN = flex.StringValueToFramework(ctx, state.ID.ValueString())
+	state.AssignmentRequired = flex.BoolToFramework(ctx, out.AssignmentRequired)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceApplicationAssignmentConfiguration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	var plan, state resourceApplicationAssignmentConfigurationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AssignmentRequired.Equal(state.AssignmentRequired) {
+		in := &ssoadmin.PutApplicationAssignmentConfigurationInput{
+			ApplicationArn:     aws.String(plan.ApplicationARN.ValueString()),
+			AssignmentRequired: aws.Bool(plan.AssignmentRequired.ValueBool()),
+		}
+
+		_, err := conn.PutApplicationAssignmentConfigurationWithContext(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionUpdating, ResNameApplicationAssignmentConfiguration, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete restores the default configuration, in which assignments are required.
+func (r *resourceApplicationAssignmentConfiguration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	var state resourceApplicationAssignmentConfigurationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutApplicationAssignmentConfigurationWithContext(ctx, &ssoadmin.PutApplicationAssignmentConfigurationInput{
+		ApplicationArn:     aws.String(state.ApplicationARN.ValueString()),
+		AssignmentRequired: aws.Bool(true),
+	})
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionDeleting, ResNameApplicationAssignmentConfiguration, state.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+func FindApplicationAssignmentConfigurationByID(ctx context.Context, conn *ssoadmin.SSOAdmin, id string) (*ssoadmin.GetApplicationAssignmentConfigurationOutput, error) {
+	in := &ssoadmin.GetApplicationAssignmentConfigurationInput{
+		ApplicationArn: aws.String(id),
+	}
+
+	out, err := conn.GetApplicationAssignmentConfigurationWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AssignmentRequired == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceApplicationAssignmentConfigurationData struct {
+	ApplicationARN     types.String `tfsdk:"application_arn"`
+	AssignmentRequired types.Bool   `tfsdk:"assignment_required"`
+	ID                 types.String `tfsdk:"id"`
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Application Providers")
+func newDataSourceApplicationProviders(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceApplicationProviders{}, nil
+}
+
+const (
+	DSNameApplicationProviders = "Application Providers Data Source"
+)
+
+type dataSourceApplicationProviders struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceApplicationProviders) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_application_providers"
+}
+
+func (d *dataSourceApplicationProviders) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_providers": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: applicationProviderAttrTypes},
+				Computed:    true,
+			},
+			"id": framework.IDAttribute(),
+		},
+	}
+}
+
+func (d *dataSourceApplicationProviders) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().SSOAdminConn(ctx)
+
+	var data dataSourceApplicationProvidersData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var applicationProviders []*ssoadmin.ApplicationProvider
+	err := conn.ListApplicationProvidersPagesWithContext(ctx, &ssoadmin.ListApplicationProvidersInput{}, func(page *ssoadmin.ListApplicationProvidersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		applicationProviders = append(applicationProviders, page.ApplicationProviders...)
+
+		return !lastPage
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionReading, DSNameApplicationProviders, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().Region)
+
+	var diags diag.Diagnostics
+	data.ApplicationProviders, diags = flattenApplicationProviders(ctx, applicationProviders)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+var (
+	displayDataAttrTypes = map[string]attr.Type{
+		"description":  types.StringType,
+		"display_name": types.StringType,
+		"icon_url":     types.StringType,
+	}
+
+	applicationProviderAttrTypes = map[string]attr.Type{
+		"application_provider_arn": types.StringType,
+		"display_data":             types.ListType{ElemType: types.ObjectType{AttrTypes: displayDataAttrTypes}},
+		"federation_protocol":      types.StringType,
+	}
+)
+
+type dataSourceApplicationProvidersData struct {
+	ApplicationProviders types.List   `tfsdk:"application_providers"`
+	ID                   types.String `tfsdk:"id"`
+}
+
+func flattenApplicationProviders(ctx context.Context, apiObjects []*ssoadmin.ApplicationProvider) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: applicationProviderAttrTypes}
+	displayDataElemType := types.ObjectType{AttrTypes: displayDataAttrTypes}
+
+	elems := []attr.Value{}
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		displayData := types.ListValueMust(displayDataElemType, []attr.Value{})
+		if v := apiObject.DisplayData; v != nil {
+			objVal, d := types.ObjectValue(displayDataAttrTypes, map[string]attr.Value{
+				"description":  flex.StringToFramework(ctx, v.Description),
+				"display_name": flex.StringToFramework(ctx, v.DisplayName),
+				"icon_url":     flex.StringToFramework(ctx, v.IconUrl),
+			})
+			diags.Append(d...)
+			listVal, d := types.ListValue(displayDataElemType, []attr.Value{objVal})
+			diags.Append(d...)
+			displayData = listVal
+		}
+
+		objVal, d := types.ObjectValue(applicationProviderAttrTypes, map[string]attr.Value{
+			"application_provider_arn": flex.StringToFramework(ctx, apiObject.ApplicationProviderArn),
+			"display_data":             displayData,
+			"federation_protocol":      flex.StringToFramework(ctx, apiObject.FederationProtocol),
+		})
+		diags.Append(d...)
+		elems = append(elems, objVal)
+	}
+
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+
+	return listVal, diags
+}
@@ -5,10 +5,13 @@ package ssoadmin
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -146,3 +149,90 @@ func FindPermissionsBoundary(ctx context.Context, conn *ssoadmin.SSOAdmin, permi
 
 	return output.PermissionsBoundary, nil
 }
+
+// findAccountAssignmentsByMatrix returns the subset of the specified account assignments that exist.
+// ListAccountAssignments is called once per target account and permission set pair, with at most maxConcurrency calls in flight.
+func findAccountAssignmentsByMatrix(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceARN string, matrix accountAssignmentsMatrix, maxConcurrency int) (accountAssignmentsMatrix, error) {
+	type pair struct {
+		permissionSetARN string
+		targetID         string
+	}
+
+	pairs := make(map[pair]struct{})
+	for k := range matrix {
+		pairs[pair{permissionSetARN: k.PermissionSetARN, targetID: k.TargetID}] = struct{}{}
+	}
+
+	var (
+		errs *multierror.Error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	result := make(accountAssignmentsMatrix)
+	sem := make(chan struct{}, maxConcurrency)
+
+	for p := range pairs {
+		p := p
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			input := &ssoadmin.ListAccountAssignmentsInput{
+				AccountId:        aws.String(p.targetID),
+				InstanceArn:      aws.String(instanceARN),
+				PermissionSetArn: aws.String(p.permissionSetARN),
+			}
+			var found []accountAssignmentKey
+
+			err := conn.ListAccountAssignmentsPagesWithContext(ctx, input, func(page *ssoadmin.ListAccountAssignmentsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, a := range page.AccountAssignments {
+					if a == nil {
+						continue
+					}
+
+					k := accountAssignmentKey{
+						PermissionSetARN: p.permissionSetARN,
+						PrincipalID:      aws.StringValue(a.PrincipalId),
+						PrincipalType:    aws.StringValue(a.PrincipalType),
+						TargetID:         p.targetID,
+					}
+
+					if _, ok := matrix[k]; ok {
+						found = append(found, k)
+					}
+				}
+
+				return !lastPage
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("listing SSO Account Assignments for AccountId (%s) PermissionSet (%s): %w", p.targetID, p.permissionSetARN, err))
+				return
+			}
+
+			for _, k := range found {
+				result[k] = struct{}{}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
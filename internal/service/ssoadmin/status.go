@@ -5,9 +5,11 @@ package ssoadmin
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
@@ -80,3 +82,159 @@ func statusPermissionSetProvisioning(ctx context.Context, conn *ssoadmin.SSOAdmi
 		return resp.PermissionSetProvisioningStatus, aws.StringValue(resp.PermissionSetProvisioningStatus.Status), nil
 	}
 }
+
+// accountAssignmentRequests tracks outstanding account assignment provisioning requests by request ID.
+type accountAssignmentRequests struct {
+	creations map[string]accountAssignmentKey
+	deletions map[string]accountAssignmentKey
+	failures  *multierror.Error
+
+	// Request IDs that were in the in-progress lists at the previous poll, nil before the first poll.
+	creationsInProgress map[string]struct{}
+	deletionsInProgress map[string]struct{}
+}
+
+func (r *accountAssignmentRequests) pending() int {
+	return len(r.creations) + len(r.deletions)
+}
+
+// refresh removes the requests that have completed from outstanding, recording any failures.
+// A request is described only when it has dropped out of the in-progress list since the previous poll,
+// on the first poll, or when none of the outstanding requests are listed as in progress.
+// It returns the request IDs listed as in progress, to be passed as previous to the next call.
+func (r *accountAssignmentRequests) refresh(outstanding map[string]accountAssignmentKey, previous, inProgress map[string]struct{}, status func(string) retry.StateRefreshFunc, operation string) (map[string]struct{}, error) {
+	anyInProgress := false
+	for requestID := range outstanding {
+		if _, ok := inProgress[requestID]; ok {
+			anyInProgress = true
+			break
+		}
+	}
+
+	for requestID, k := range outstanding {
+		if _, ok := inProgress[requestID]; ok {
+			continue
+		}
+
+		if _, ok := previous[requestID]; !ok && previous != nil && anyInProgress {
+			continue
+		}
+
+		outputRaw, state, err := status(requestID)()
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch state {
+		case accountAssignmentStatusNotFound, ssoadmin.StatusValuesInProgress:
+			continue
+		case ssoadmin.StatusValuesFailed:
+			var reason string
+			if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
+				reason = aws.StringValue(v.FailureReason)
+			}
+			r.failures = multierror.Append(r.failures, fmt.Errorf("%s SSO Account Assignment for %s: %s", operation, k, reason))
+		}
+
+		delete(outstanding, requestID)
+	}
+
+	return inProgress, nil
+}
+
+// statusAccountAssignmentRequests polls all outstanding requests together. The in-progress requests are
+// listed once per poll and only requests that have left that list are described individually.
+func statusAccountAssignmentRequests(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceArn string, requests *accountAssignmentRequests) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		if len(requests.creations) > 0 {
+			inProgress, err := listAccountAssignmentCreationRequestsInProgress(ctx, conn, instanceArn)
+
+			if err != nil {
+				return nil, accountAssignmentStatusUnknown, err
+			}
+
+			requests.creationsInProgress, err = requests.refresh(requests.creations, requests.creationsInProgress, inProgress, func(requestID string) retry.StateRefreshFunc {
+				return statusAccountAssignmentCreation(ctx, conn, instanceArn, requestID)
+			}, "creating")
+
+			if err != nil {
+				return nil, accountAssignmentStatusUnknown, err
+			}
+		}
+
+		if len(requests.deletions) > 0 {
+			inProgress, err := listAccountAssignmentDeletionRequestsInProgress(ctx, conn, instanceArn)
+
+			if err != nil {
+				return nil, accountAssignmentStatusUnknown, err
+			}
+
+			requests.deletionsInProgress, err = requests.refresh(requests.deletions, requests.deletionsInProgress, inProgress, func(requestID string) retry.StateRefreshFunc {
+				return statusAccountAssignmentDeletion(ctx, conn, instanceArn, requestID)
+			}, "deleting")
+
+			if err != nil {
+				return nil, accountAssignmentStatusUnknown, err
+			}
+		}
+
+		if requests.pending() > 0 {
+			return requests, ssoadmin.StatusValuesInProgress, nil
+		}
+
+		return requests, ssoadmin.StatusValuesSucceeded, nil
+	}
+}
+
+func listAccountAssignmentCreationRequestsInProgress(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceArn string) (map[string]struct{}, error) {
+	input := &ssoadmin.ListAccountAssignmentCreationStatusInput{
+		Filter: &ssoadmin.OperationStatusFilter{
+			Status: aws.String(ssoadmin.StatusValuesInProgress),
+		},
+		InstanceArn: aws.String(instanceArn),
+	}
+	requestIDs := make(map[string]struct{})
+
+	err := conn.ListAccountAssignmentCreationStatusPagesWithContext(ctx, input, func(page *ssoadmin.ListAccountAssignmentCreationStatusOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AccountAssignmentsCreationStatus {
+			if v != nil {
+				requestIDs[aws.StringValue(v.RequestId)] = struct{}{}
+			}
+		}
+
+		return !lastPage
+	})
+
+	return requestIDs, err
+}
+
+func listAccountAssignmentDeletionRequestsInProgress(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceArn string) (map[string]struct{}, error) {
+	input := &ssoadmin.ListAccountAssignmentDeletionStatusInput{
+		Filter: &ssoadmin.OperationStatusFilter{
+			Status: aws.String(ssoadmin.StatusValuesInProgress),
+		},
+		InstanceArn: aws.String(instanceArn),
+	}
+	requestIDs := make(map[string]struct{})
+
+	err := conn.ListAccountAssignmentDeletionStatusPagesWithContext(ctx, input, func(page *ssoadmin.ListAccountAssignmentDeletionStatusOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AccountAssignmentsDeletionStatus {
+			if v != nil {
+				requestIDs[aws.StringValue(v.RequestId)] = struct{}{}
+			}
+		}
+
+		return !lastPage
+	})
+
+	return requestIDs, err
+}
@@ -0,0 +1,449 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Trusted Token Issuer")
+// @Tags
+func newResourceTrustedTokenIssuer(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceTrustedTokenIssuer{}, nil
+}
+
+const (
+	ResNameTrustedTokenIssuer = "Trusted Token Issuer"
+
+	trustedTokenIssuerIDSeparator = ","
+)
+
+type resourceTrustedTokenIssuer struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceTrustedTokenIssuer) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_trusted_token_issuer"
+}
+
+func (r *resourceTrustedTokenIssuer) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": framework.ARNAttributeComputedOnly(),
+			"id":  framework.IDAttribute(),
+			"instance_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"trusted_token_issuer_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(ssoadmin.TrustedTokenIssuerType_Values()...),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"trusted_token_issuer_configuration": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"oidc_jwt_configuration": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"claim_attribute_path": schema.StringAttribute{
+										Required: true,
+									},
+									"identity_store_attribute_path": schema.StringAttribute{
+										Required: true,
+									},
+									"issuer_url": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									"jwks_retrieval_option": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(ssoadmin.JwksRetrievalOption_Values()...),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceTrustedTokenIssuer) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	var plan resourceTrustedTokenIssuerData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, d := expandTrustedTokenIssuerConfiguration(ctx, plan.TrustedTokenIssuerConfiguration)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &ssoadmin.CreateTrustedTokenIssuerInput{
+		InstanceArn:                     aws.String(plan.InstanceARN.ValueString()),
+		Name:                            aws.String(plan.Name.ValueString()),
+		Tags:                            getTagsIn(ctx),
+		TrustedTokenIssuerConfiguration: configuration,
+		TrustedTokenIssuerType:          aws.String(plan.TrustedTokenIssuerType.ValueString()),
+	}
+
+	out, err := conn.CreateTrustedTokenIssuerWithContext(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameTrustedTokenIssuer, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || out.TrustedTokenIssuerArn == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameTrustedTokenIssuer, plan.Name.String(), nil),
+			"empty output",
+		)
+		return
+	}
+
+	plan.ARN = flex.StringToFramework(ctx, out.TrustedTokenIssuerArn)
+	plan.ID = types.StringValue(TrustedTokenIssuerCreateResourceID(aws.StringValue(out.TrustedTokenIssuerArn), plan.InstanceARN.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceTrustedTokenIssuer) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	var state resourceTrustedTokenIssuerData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	arn, instanceARN, err := TrustedTokenIssuerParseResourceID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionSetting, ResNameTrustedTokenIssuer, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := FindTrustedTokenIssuerByARN(ctx, conn, arn)
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionSetting, ResNameTrustedTokenIssuer, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.ARN = flex.StringToFramework(ctx, out.TrustedTokenIssuerArn)
+	state.InstanceARN = flex.StringValueToFramework(ctx, instanceARN)
+	state.Name = flex.StringToFramework(ctx, out.Name)
+	state.TrustedTokenIssuerType = flex.StringToFramework(ctx, out.TrustedTokenIssuerType)
+	configuration, d := flattenTrustedTokenIssuerConfiguration(ctx, out.TrustedTokenIssuerConfiguration)
+	resp.Diagnostics.Append(d...)
+	state.TrustedTokenIssuerConfiguration = configuration
+
+	tags, err := listTags(ctx, conn, arn, instanceARN)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionSetting, ResNameTrustedTokenIssuer, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	setTagsOut(ctx, Tags(tags))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceTrustedTokenIssuer) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	var plan, state resourceTrustedTokenIssuerData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) ||
+		!plan.TrustedTokenIssuerConfiguration.Equal(state.TrustedTokenIssuerConfiguration) {
+		in := &ssoadmin.UpdateTrustedTokenIssuerInput{
+			Name:                  aws.String(plan.Name.ValueString()),
+			TrustedTokenIssuerArn: aws.String(plan.ARN.ValueString()),
+		}
+
+		if !plan.TrustedTokenIssuerConfiguration.Equal(state.TrustedTokenIssuerConfiguration) {
+			configuration, d := expandTrustedTokenIssuerConfiguration(ctx, plan.TrustedTokenIssuerConfiguration)
+			resp.Diagnostics.Append(d...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if v := configuration.OidcJwtConfiguration; v != nil {
+				in.TrustedTokenIssuerConfiguration = &ssoadmin.TrustedTokenIssuerUpdateConfiguration{
+					OidcJwtConfiguration: &ssoadmin.OidcJwtUpdateConfiguration{
+						ClaimAttributePath:         v.ClaimAttributePath,
+						IdentityStoreAttributePath: v.IdentityStoreAttributePath,
+						JwksRetrievalOption:        v.JwksRetrievalOption,
+					},
+				}
+			}
+		}
+
+		_, err := conn.UpdateTrustedTokenIssuerWithContext(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionUpdating, ResNameTrustedTokenIssuer, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.TagsAll.Equal(state.TagsAll) {
+		if err := updateTags(ctx, conn, plan.ARN.ValueString(), plan.InstanceARN.ValueString(), state.TagsAll, plan.TagsAll); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionUpdating, ResNameTrustedTokenIssuer, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceTrustedTokenIssuer) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().SSOAdminConn(ctx)
+
+	var state resourceTrustedTokenIssuerData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteTrustedTokenIssuerWithContext(ctx, &ssoadmin.DeleteTrustedTokenIssuerInput{
+		TrustedTokenIssuerArn: aws.String(state.ARN.ValueString()),
+	})
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionDeleting, ResNameTrustedTokenIssuer, state.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+func (r *resourceTrustedTokenIssuer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *resourceTrustedTokenIssuer) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, req, resp)
+}
+
+func FindTrustedTokenIssuerByARN(ctx context.Context, conn *ssoadmin.SSOAdmin, arn string) (*ssoadmin.DescribeTrustedTokenIssuerOutput, error) {
+	in := &ssoadmin.DescribeTrustedTokenIssuerInput{
+		TrustedTokenIssuerArn: aws.String(arn),
+	}
+
+	out, err := conn.DescribeTrustedTokenIssuerWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+// The instance ARN is part of the ID as the tagging APIs require it.
+func TrustedTokenIssuerCreateResourceID(trustedTokenIssuerARN, instanceARN string) string {
+	parts := []string{trustedTokenIssuerARN, instanceARN}
+	id := strings.Join(parts, trustedTokenIssuerIDSeparator)
+
+	return id
+}
+
+func TrustedTokenIssuerParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, trustedTokenIssuerIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TRUSTED_TOKEN_ISSUER_ARN%[2]sINSTANCE_ARN", id, trustedTokenIssuerIDSeparator)
+}
+
+var (
+	oidcJWTConfigurationAttrTypes = map[string]attr.Type{
+		"claim_attribute_path":          types.StringType,
+		"identity_store_attribute_path": types.StringType,
+		"issuer_url":                    types.StringType,
+		"jwks_retrieval_option":         types.StringType,
+	}
+
+	trustedTokenIssuerConfigurationAttrTypes = map[string]attr.Type{
+		"oidc_jwt_configuration": types.ListType{ElemType: types.ObjectType{AttrTypes: oidcJWTConfigurationAttrTypes}},
+	}
+)
+
+type resourceTrustedTokenIssuerData struct {
+	ARN                             types.String `tfsdk:"arn"`
+	ID                              types.String `tfsdk:"id"`
+	InstanceARN                     types.String `tfsdk:"instance_arn"`
+	Name                            types.String `tfsdk:"name"`
+	Tags                            types.Map    `tfsdk:"tags"`
+	TagsAll                         types.Map    `tfsdk:"tags_all"`
+	TrustedTokenIssuerConfiguration types.List   `tfsdk:"trusted_token_issuer_configuration"`
+	TrustedTokenIssuerType          types.String `tfsdk:"trusted_token_issuer_type"`
+}
+
+type trustedTokenIssuerConfigurationData struct {
+	OIDCJWTConfiguration types.List `tfsdk:"oidc_jwt_configuration"`
+}
+
+type oidcJWTConfigurationData struct {
+	ClaimAttributePath         types.String `tfsdk:"claim_attribute_path"`
+	IdentityStoreAttributePath types.String `tfsdk:"identity_store_attribute_path"`
+	IssuerURL                  types.String `tfsdk:"issuer_url"`
+	JWKSRetrievalOption        types.String `tfsdk:"jwks_retrieval_option"`
+}
+
+func expandTrustedTokenIssuerConfiguration(ctx context.Context, tfList types.List) (*ssoadmin.TrustedTokenIssuerConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var configurations []trustedTokenIssuerConfigurationData
+	diags.Append(tfList.ElementsAs(ctx, &configurations, false)...)
+	if diags.HasError() || len(configurations) == 0 {
+		return nil, diags
+	}
+
+	apiObject := &ssoadmin.TrustedTokenIssuerConfiguration{}
+
+	var oidcJWTConfigurations []oidcJWTConfigurationData
+	diags.Append(configurations[0].OIDCJWTConfiguration.ElementsAs(ctx, &oidcJWTConfigurations, false)...)
+	if len(oidcJWTConfigurations) > 0 {
+		tfObj := oidcJWTConfigurations[0]
+		apiObject.OidcJwtConfiguration = &ssoadmin.OidcJwtConfiguration{
+			ClaimAttributePath:         aws.String(tfObj.ClaimAttributePath.ValueString()),
+			IdentityStoreAttributePath: aws.String(tfObj.IdentityStoreAttributePath.ValueString()),
+			IssuerUrl:                  aws.String(tfObj.IssuerURL.ValueString()),
+			JwksRetrievalOption:        aws.String(tfObj.JWKSRetrievalOption.ValueString()),
+		}
+	}
+
+	return apiObject, diags
+}
+
+func flattenTrustedTokenIssuerConfiguration(ctx context.Context, apiObject *ssoadmin.TrustedTokenIssuerConfiguration) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: trustedTokenIssuerConfigurationAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{}), diags
+	}
+
+	oidcJWTElemType := types.ObjectType{AttrTypes: oidcJWTConfigurationAttrTypes}
+	oidcJWTConfiguration := types.ListValueMust(oidcJWTElemType, []attr.Value{})
+	if v := apiObject.OidcJwtConfiguration; v != nil {
+		obj := map[string]attr.Value{
+			"claim_attribute_path":          flex.StringToFramework(ctx, v.ClaimAttributePath),
+			"identity_store_attribute_path": flex.StringToFramework(ctx, v.IdentityStoreAttributePath),
+			"issuer_url":                    flex.StringToFramework(ctx, v.IssuerUrl),
+			"jwks_retrieval_option":         flex.StringToFramework(ctx, v.JwksRetrievalOption),
+		}
+
+		objVal, d := types.ObjectValue(oidcJWTConfigurationAttrTypes, obj)
+		diags.Append(d...)
+		listVal, d := types.ListValue(oidcJWTElemType, []attr.Value{objVal})
+		diags.Append(d...)
+		oidcJWTConfiguration = listVal
+	}
+
+	objVal, d := types.ObjectValue(trustedTokenIssuerConfigurationAttrTypes, map[string]attr.Value{
+		"oidc_jwt_configuration": oidcJWTConfiguration,
+	})
+	diags.Append(d...)
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
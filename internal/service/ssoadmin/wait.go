@@ -5,6 +5,7 @@ package ssoadmin
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
@@ -70,3 +71,24 @@ func waitPermissionSetProvisioned(ctx context.Context, conn *ssoadmin.SSOAdmin,
 	}
 	return nil, err
 }
+
+func waitAccountAssignmentsProvisioned(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceArn string, requests *accountAssignmentRequests, timeout time.Duration) error {
+	if requests.pending() == 0 {
+		return nil
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{ssoadmin.StatusValuesInProgress},
+		Target:     []string{ssoadmin.StatusValuesSucceeded},
+		Refresh:    statusAccountAssignmentRequests(ctx, conn, instanceArn, requests),
+		Timeout:    timeout,
+		Delay:      accountAssignmentDelay,
+		MinTimeout: accountAssignmentMinTimeout,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %d SSO Account Assignment requests: %w", requests.pending(), err)
+	}
+
+	return requests.failures.ErrorOrNil()
+}
@@ -353,11 +353,11 @@ func expandRetentionProperties(tfList []interface{}) *types.RetentionProperties
 	apiObject := &types.RetentionProperties{}
 
 	if v, ok := tfMap["magnetic_store_retention_period_in_days"].(int); ok {
-		apiObject.MagneticStoreRetentionPeriodInDays = int64(v)
+		apiObject.MagneticStoreRetentionPeriodInDays = aws.Int64(int64(v))
 	}
 
 	if v, ok := tfMap["memory_store_retention_period_in_hours"].(int); ok {
-		apiObject.MemoryStoreRetentionPeriodInHours = int64(v)
+		apiObject.MemoryStoreRetentionPeriodInHours = aws.Int64(int64(v))
 	}
 
 	return apiObject
@@ -369,8 +369,8 @@ func flattenRetentionProperties(apiObject *types.RetentionProperties) []interfac
 	}
 
 	tfMap := map[string]interface{}{
-		"magnetic_store_retention_period_in_days": apiObject.MagneticStoreRetentionPeriodInDays,
-		"memory_store_retention_period_in_hours":  apiObject.MemoryStoreRetentionPeriodInHours,
+		"magnetic_store_retention_period_in_days": aws.ToInt64(apiObject.MagneticStoreRetentionPeriodInDays),
+		"memory_store_retention_period_in_hours":  aws.ToInt64(apiObject.MemoryStoreRetentionPeriodInHours),
 	}
 
 	return []interface{}{tfMap}
@@ -6,6 +6,7 @@ package types
 import (
 	"fmt"
 	"net"
+	"net/netip"
 )
 
 // ValidateCIDRBlock validates that the specified CIDR block is valid:
@@ -52,3 +53,80 @@ func CanonicalCIDRBlock(cidr string) string {
 
 	return ipnet.String()
 }
+
+// CIDRBlockFirstFit returns the lowest CIDR block with the specified netmask length within the
+// parent CIDR block that does not overlap any of the allocated CIDR blocks.
+// The returned CIDR block is naturally aligned. Both IPv4 and IPv6 CIDR blocks are supported.
+// The boolean result is false if there is no free CIDR block of that size.
+func CIDRBlockFirstFit(parent string, netmaskLength int, allocated []string) (string, bool, error) {
+	parentPrefix, err := netip.ParsePrefix(parent)
+	if err != nil {
+		return "", false, fmt.Errorf("%q is not a valid CIDR block: %w", parent, err)
+	}
+	parentPrefix = parentPrefix.Masked()
+
+	if netmaskLength < parentPrefix.Bits() || netmaskLength > parentPrefix.Addr().BitLen() {
+		return "", false, fmt.Errorf("netmask length (%d) must be between %d and %d", netmaskLength, parentPrefix.Bits(), parentPrefix.Addr().BitLen())
+	}
+
+	allocatedPrefixes := make([]netip.Prefix, 0, len(allocated))
+	for _, v := range allocated {
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil {
+			return "", false, fmt.Errorf("%q is not a valid CIDR block: %w", v, err)
+		}
+
+		allocatedPrefixes = append(allocatedPrefixes, prefix.Masked())
+	}
+
+	prefix, ok := firstFitPrefix(parentPrefix, netmaskLength, allocatedPrefixes)
+	if !ok {
+		return "", false, nil
+	}
+
+	return prefix.String(), true, nil
+}
+
+// firstFitPrefix searches prefix for the lowest free block of the specified length,
+// halving it as long as it overlaps allocated blocks.
+func firstFitPrefix(prefix netip.Prefix, bits int, allocated []netip.Prefix) (netip.Prefix, bool) {
+	var overlapping []netip.Prefix
+	for _, v := range allocated {
+		if !v.Overlaps(prefix) {
+			continue
+		}
+
+		if v.Bits() <= prefix.Bits() {
+			// The allocated block contains the whole prefix.
+			return netip.Prefix{}, false
+		}
+
+		overlapping = append(overlapping, v)
+	}
+
+	if len(overlapping) == 0 {
+		return netip.PrefixFrom(prefix.Addr(), bits), true
+	}
+
+	if prefix.Bits() == bits {
+		return netip.Prefix{}, false
+	}
+
+	lower, upper := splitPrefix(prefix)
+
+	if v, ok := firstFitPrefix(lower, bits, overlapping); ok {
+		return v, true
+	}
+
+	return firstFitPrefix(upper, bits, overlapping)
+}
+
+// splitPrefix returns the lower and upper halves of a prefix.
+func splitPrefix(prefix netip.Prefix) (netip.Prefix, netip.Prefix) {
+	bits := prefix.Bits() + 1
+	b := prefix.Addr().AsSlice()
+	b[(bits-1)/8] |= 0x80 >> ((bits - 1) % 8)
+	upper, _ := netip.AddrFromSlice(b)
+
+	return netip.PrefixFrom(prefix.Addr(), bits), netip.PrefixFrom(upper, bits)
+}
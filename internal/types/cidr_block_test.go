@@ -72,3 +72,46 @@ func TestCanonicalCIDRBlock(t *testing.T) {
 		}
 	}
 }
+
+func TestCIDRBlockFirstFit(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		parent        string
+		netmaskLength int
+		allocated     []string
+		want          string
+		ok            bool
+		err           bool
+	}{
+		{"10.0.0.0/16", 24, nil, "10.0.0.0/24", true, false},
+		{"10.0.0.0/16", 24, []string{"10.0.0.0/24"}, "10.0.1.0/24", true, false},
+		{"10.0.0.0/16", 24, []string{"10.0.1.0/24"}, "10.0.0.0/24", true, false},
+		{"10.0.0.0/16", 23, []string{"10.0.1.0/24"}, "10.0.2.0/23", true, false},
+		{"10.0.0.0/16", 24, []string{"10.0.0.0/17"}, "10.0.128.0/24", true, false},
+		{"10.0.0.0/16", 28, []string{"10.0.0.0/28", "10.0.0.32/28"}, "10.0.0.16/28", true, false},
+		{"10.0.0.0/16", 24, []string{"10.0.0.0/8"}, "", false, false},
+		{"10.0.0.0/24", 25, []string{"10.0.0.0/25", "10.0.0.128/26"}, "", false, false},
+		{"10.0.0.0/16", 24, []string{"192.168.0.0/24"}, "10.0.0.0/24", true, false},
+		{"10.0.0.0/24", 16, nil, "", false, true},
+		{"10.0.0.0/24", 33, nil, "", false, true},
+		{"10.0.0.0/24", 25, []string{"10.0.0.0"}, "", false, true},
+		{"2001:db8::/56", 64, []string{"2001:db8::/64", "2001:db8:0:2::/63"}, "2001:db8:0:1::/64", true, false},
+		{"2001:db8::/56", 60, []string{"2001:db8::/64"}, "2001:db8:0:10::/60", true, false},
+		{"2001:db8::/48", 64, []string{"2001:db8::/49"}, "2001:db8:0:8000::/64", true, false},
+	} {
+		got, ok, err := CIDRBlockFirstFit(ts.parent, ts.netmaskLength, ts.allocated)
+		if ts.err {
+			if err == nil {
+				t.Fatalf("CIDRBlockFirstFit(%q, %d, %q) should error but didn't", ts.parent, ts.netmaskLength, ts.allocated)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("CIDRBlockFirstFit(%q, %d, %q) unexpected error: %s", ts.parent, ts.netmaskLength, ts.allocated, err)
+		}
+		if got != ts.want || ok != ts.ok {
+			t.Fatalf("CIDRBlockFirstFit(%q, %d, %q) = %q, %t; want %q, %t", ts.parent, ts.netmaskLength, ts.allocated, got, ok, ts.want, ts.ok)
+		}
+	}
+}